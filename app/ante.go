@@ -19,6 +19,8 @@ import (
 	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/oracle"
 	oraclekeeper "github.com/sei-protocol/sei-chain/x/oracle/keeper"
+	"github.com/sei-protocol/sei-chain/x/tagging"
+	taggingkeeper "github.com/sei-protocol/sei-chain/x/tagging/keeper"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -31,9 +33,11 @@ type HandlerOptions struct {
 	WasmKeeper          *wasm.Keeper
 	OracleKeeper        *oraclekeeper.Keeper
 	DexKeeper           *dexkeeper.Keeper
+	TaggingKeeper       *taggingkeeper.Keeper
 	AccessControlKeeper *aclkeeper.Keeper
 	TXCounterStoreKey   sdk.StoreKey
 	CheckTxMemState     *dexcache.MemState
+	ContractQuarantine  *antedecorators.ContractQuarantine
 
 	TracingInfo *tracing.Info
 }
@@ -57,6 +61,9 @@ func NewAnteHandlerAndDepGenerator(options HandlerOptions) (sdk.AnteHandler, sdk
 	if options.OracleKeeper == nil {
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "oracle keeper is required for ante builder")
 	}
+	if options.TaggingKeeper == nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tagging keeper is required for ante builder")
+	}
 	if options.AccessControlKeeper == nil {
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "accesscontrol keeper is required for ante builder")
 	}
@@ -66,6 +73,9 @@ func NewAnteHandlerAndDepGenerator(options HandlerOptions) (sdk.AnteHandler, sdk
 	if options.CheckTxMemState == nil {
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "checktx memstate is required for ante builder")
 	}
+	if options.ContractQuarantine == nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "contract quarantine is required for ante builder")
+	}
 
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -82,11 +92,14 @@ func NewAnteHandlerAndDepGenerator(options HandlerOptions) (sdk.AnteHandler, sdk
 		oracle.NewSpammingPreventionDecorator(*options.OracleKeeper),
 		oracle.NewOracleVoteAloneDecorator(),
 		sdk.DefaultWrappedAnteDecorator(ante.NewValidateBasicDecorator()),
+		sdk.DefaultWrappedAnteDecorator(tagging.NewTaggedAddressDecorator(*options.TaggingKeeper)),
 		sdk.DefaultWrappedAnteDecorator(ante.NewTxTimeoutHeightDecorator()),
 		sdk.DefaultWrappedAnteDecorator(ante.NewValidateMemoDecorator(options.AccountKeeper)),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		// PriorityDecorator must be called after DeductFeeDecorator which sets tx priority based on tx fees
 		sdk.DefaultWrappedAnteDecorator(antedecorators.NewPriorityDecorator()),
+		// ContractQuarantineDecorator must be called after PriorityDecorator so that deprioritized txs stay deprioritized
+		sdk.DefaultWrappedAnteDecorator(antedecorators.NewContractQuarantineDecorator(options.ContractQuarantine)),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		sdk.CustomDepWrappedAnteDecorator(ante.NewSetPubKeyDecorator(options.AccountKeeper), depdecorators.SignerDepDecorator{ReadOnly: false}),
 		sdk.DefaultWrappedAnteDecorator(ante.NewValidateSigCountDecorator(options.AccountKeeper)),
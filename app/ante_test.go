@@ -90,9 +90,11 @@ func (suite *AnteTestSuite) SetupTest(isCheckTx bool) {
 			WasmKeeper:          &suite.App.WasmKeeper,
 			OracleKeeper:        &suite.App.OracleKeeper,
 			DexKeeper:           &suite.App.DexKeeper,
+			TaggingKeeper:       &suite.App.TaggingKeeper,
 			AccessControlKeeper: &suite.App.AccessControlKeeper,
 			TracingInfo:         tracingInfo,
 			CheckTxMemState:     suite.App.CheckTxMemState,
+			ContractQuarantine:  suite.App.ContractQuarantine,
 		},
 	)
 
@@ -0,0 +1,235 @@
+package antedecorators
+
+import (
+	"fmt"
+	"sync"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	ContractQuarantineReject       = "reject"
+	ContractQuarantineDeprioritize = "deprioritize"
+
+	quarantinedAction = "quarantined"
+	rejectedAction    = "rejected"
+)
+
+// ContractQuarantineConfig configures the node-local quarantine of wasm
+// contracts whose recent executions mostly revert. A contract is quarantined
+// once, over the last WindowBlocks blocks, it was executed at least
+// MinExecutions times, more than MaxRevertRate of those executions failed and
+// the failed executions used more than MaxWastedGas gas.
+type ContractQuarantineConfig struct {
+	Enable bool
+	// Mode is either reject, which fails CheckTx, or deprioritize, which gives
+	// the tx the lowest mempool priority
+	Mode             string
+	WindowBlocks     int64
+	MinExecutions    uint64
+	MaxRevertRate    float64
+	MaxWastedGas     uint64
+	QuarantineBlocks int64
+}
+
+func DefaultContractQuarantineConfig() ContractQuarantineConfig {
+	return ContractQuarantineConfig{
+		Enable:           false,
+		Mode:             ContractQuarantineDeprioritize,
+		WindowBlocks:     100,
+		MinExecutions:    20,
+		MaxRevertRate:    0.5,
+		MaxWastedGas:     10000000,
+		QuarantineBlocks: 1000,
+	}
+}
+
+func (c ContractQuarantineConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Mode != ContractQuarantineReject && c.Mode != ContractQuarantineDeprioritize {
+		return fmt.Errorf("contract quarantine mode must be %s or %s, got %s", ContractQuarantineReject, ContractQuarantineDeprioritize, c.Mode)
+	}
+	if c.WindowBlocks <= 0 {
+		return fmt.Errorf("contract quarantine window must be positive, got %d", c.WindowBlocks)
+	}
+	if c.MinExecutions == 0 {
+		return fmt.Errorf("contract quarantine min executions must be positive")
+	}
+	if c.MaxRevertRate < 0 || c.MaxRevertRate >= 1 {
+		return fmt.Errorf("contract quarantine max revert rate must be in [0, 1), got %f", c.MaxRevertRate)
+	}
+	if c.QuarantineBlocks <= 0 {
+		return fmt.Errorf("contract quarantine duration must be positive, got %d", c.QuarantineBlocks)
+	}
+	return nil
+}
+
+type contractExecutions struct {
+	executions uint64
+	reverts    uint64
+	wastedGas  uint64
+}
+
+// ContractQuarantine tracks the outcome of recent wasm contract executions and
+// the contracts quarantined because of them. It only lives in memory and
+// never affects state, so every node may configure it differently.
+type ContractQuarantine struct {
+	config ContractQuarantineConfig
+
+	mtx              sync.RWMutex
+	height           int64
+	blocks           map[int64]map[string]contractExecutions
+	quarantinedUntil map[string]int64
+}
+
+func NewContractQuarantine(config ContractQuarantineConfig) *ContractQuarantine {
+	return &ContractQuarantine{
+		config:           config,
+		blocks:           map[int64]map[string]contractExecutions{},
+		quarantinedUntil: map[string]int64{},
+	}
+}
+
+// RecordBlock counts the contract executions of a processed block and updates
+// the quarantine. txs are nil for txs that failed to decode. A tx executing
+// several contracts counts once for each of them, and its gas counts as
+// wasted for all of them if it failed. Recording a height again replaces
+// what was recorded for it, so a block processed both optimistically and
+// again when finalized is only counted once.
+func (q *ContractQuarantine) RecordBlock(height int64, txs []sdk.Tx, results []*abci.ExecTxResult) {
+	if !q.config.Enable {
+		return
+	}
+	block := map[string]contractExecutions{}
+	for i, tx := range txs {
+		if tx == nil || i >= len(results) || results[i] == nil {
+			continue
+		}
+		for _, contract := range executedContracts(tx.GetMsgs()) {
+			executions := block[contract]
+			executions.executions++
+			if results[i].Code != 0 {
+				executions.reverts++
+				if results[i].GasUsed > 0 {
+					executions.wastedGas += uint64(results[i].GasUsed)
+				}
+			}
+			block[contract] = executions
+		}
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	q.height = height
+	q.blocks[height] = block
+	totals := map[string]contractExecutions{}
+	for blockHeight, executionsByContract := range q.blocks {
+		if blockHeight <= height-q.config.WindowBlocks || blockHeight > height {
+			delete(q.blocks, blockHeight)
+			continue
+		}
+		for contract, executions := range executionsByContract {
+			total := totals[contract]
+			total.executions += executions.executions
+			total.reverts += executions.reverts
+			total.wastedGas += executions.wastedGas
+			totals[contract] = total
+		}
+	}
+	for contract, total := range totals {
+		if !q.exceedsThresholds(total) {
+			continue
+		}
+		if !q.isQuarantined(contract) {
+			metrics.IncrContractQuarantineCounter(quarantinedAction)
+		}
+		q.quarantinedUntil[contract] = height + q.config.QuarantineBlocks
+	}
+	for contract := range q.quarantinedUntil {
+		if !q.isQuarantined(contract) {
+			delete(q.quarantinedUntil, contract)
+		}
+	}
+	metrics.SetQuarantinedContracts(len(q.quarantinedUntil))
+}
+
+func (q *ContractQuarantine) exceedsThresholds(total contractExecutions) bool {
+	return total.executions >= q.config.MinExecutions &&
+		float64(total.reverts) > q.config.MaxRevertRate*float64(total.executions) &&
+		total.wastedGas > q.config.MaxWastedGas
+}
+
+// IsQuarantined returns whether the contract is quarantined as of the last
+// recorded block
+func (q *ContractQuarantine) IsQuarantined(contract string) bool {
+	q.mtx.RLock()
+	defer q.mtx.RUnlock()
+	return q.isQuarantined(contract)
+}
+
+func (q *ContractQuarantine) isQuarantined(contract string) bool {
+	until, ok := q.quarantinedUntil[contract]
+	return ok && until > q.height
+}
+
+// executedContracts returns the distinct contracts the msgs execute, including
+// those executed through authz
+func executedContracts(msgs []sdk.Msg) []string {
+	contracts := []string{}
+	seen := map[string]bool{}
+	var visit func(msgs []sdk.Msg)
+	visit = func(msgs []sdk.Msg) {
+		for _, msg := range msgs {
+			switch m := msg.(type) {
+			case *wasmtypes.MsgExecuteContract:
+				if !seen[m.Contract] {
+					seen[m.Contract] = true
+					contracts = append(contracts, m.Contract)
+				}
+			case *authz.MsgExec:
+				if inner, err := m.GetMessages(); err == nil {
+					visit(inner)
+				}
+			}
+		}
+	}
+	visit(msgs)
+	return contracts
+}
+
+// ContractQuarantineDecorator applies the contract quarantine to CheckTx. It
+// never runs in DeliverTx, so blocks proposed by other nodes are unaffected.
+// It must come after the PriorityDecorator so deprioritizing isn't overridden.
+type ContractQuarantineDecorator struct {
+	quarantine *ContractQuarantine
+}
+
+func NewContractQuarantineDecorator(quarantine *ContractQuarantine) ContractQuarantineDecorator {
+	return ContractQuarantineDecorator{quarantine: quarantine}
+}
+
+func (qd ContractQuarantineDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate || !qd.quarantine.config.Enable {
+		return next(ctx, tx, simulate)
+	}
+	for _, contract := range executedContracts(tx.GetMsgs()) {
+		if !qd.quarantine.IsQuarantined(contract) {
+			continue
+		}
+		if qd.quarantine.config.Mode == ContractQuarantineReject {
+			metrics.IncrContractQuarantineCounter(rejectedAction)
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contract %s is quarantined for reverting too often", contract)
+		}
+		metrics.IncrContractQuarantineCounter(ContractQuarantineDeprioritize)
+		ctx = ctx.WithPriority(0)
+		break
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,167 @@
+package antedecorators_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/sei-protocol/sei-chain/app/antedecorators"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	spamContract   = "spam"
+	honestContract = "honest"
+)
+
+func testQuarantineConfig(mode string) antedecorators.ContractQuarantineConfig {
+	return antedecorators.ContractQuarantineConfig{
+		Enable:           true,
+		Mode:             mode,
+		WindowBlocks:     3,
+		MinExecutions:    4,
+		MaxRevertRate:    0.5,
+		MaxWastedGas:     1000,
+		QuarantineBlocks: 5,
+	}
+}
+
+func executeTx(contract string) sdk.Tx {
+	return FakeTx{FakeMsgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Contract: contract}}}
+}
+
+// recordExecutions records a block where the contract is executed executions
+// times, reverts of which fail using gasPerRevert gas each
+func recordExecutions(q *antedecorators.ContractQuarantine, height int64, contract string, executions int, reverts int, gasPerRevert int64) {
+	txs := []sdk.Tx{}
+	results := []*abci.ExecTxResult{}
+	for i := 0; i < executions; i++ {
+		txs = append(txs, executeTx(contract))
+		if i < reverts {
+			results = append(results, &abci.ExecTxResult{Code: 5, GasUsed: gasPerRevert})
+		} else {
+			results = append(results, &abci.ExecTxResult{Code: 0, GasUsed: gasPerRevert})
+		}
+	}
+	q.RecordBlock(height, txs, results)
+}
+
+func TestContractQuarantineConfigValidate(t *testing.T) {
+	require.NoError(t, antedecorators.DefaultContractQuarantineConfig().Validate())
+	require.NoError(t, antedecorators.ContractQuarantineConfig{}.Validate())
+	require.NoError(t, testQuarantineConfig(antedecorators.ContractQuarantineReject).Validate())
+
+	config := testQuarantineConfig("drop")
+	require.Error(t, config.Validate())
+	config = testQuarantineConfig(antedecorators.ContractQuarantineReject)
+	config.WindowBlocks = 0
+	require.Error(t, config.Validate())
+	config = testQuarantineConfig(antedecorators.ContractQuarantineReject)
+	config.MinExecutions = 0
+	require.Error(t, config.Validate())
+	config = testQuarantineConfig(antedecorators.ContractQuarantineReject)
+	config.MaxRevertRate = 1
+	require.Error(t, config.Validate())
+	config = testQuarantineConfig(antedecorators.ContractQuarantineReject)
+	config.QuarantineBlocks = 0
+	require.Error(t, config.Validate())
+}
+
+func TestContractQuarantineThresholds(t *testing.T) {
+	q := antedecorators.NewContractQuarantine(testQuarantineConfig(antedecorators.ContractQuarantineReject))
+
+	// 3 of 4 executions revert but only 900 gas is wasted
+	recordExecutions(q, 1, spamContract, 4, 3, 300)
+	require.False(t, q.IsQuarantined(spamContract))
+	// the window now holds 6 of 8 reverting, wasting 1800 gas
+	recordExecutions(q, 2, spamContract, 4, 3, 300)
+	require.True(t, q.IsQuarantined(spamContract))
+
+	// half reverting isn't more than the max revert rate
+	q = antedecorators.NewContractQuarantine(testQuarantineConfig(antedecorators.ContractQuarantineReject))
+	recordExecutions(q, 1, honestContract, 8, 4, 1000)
+	require.False(t, q.IsQuarantined(honestContract))
+	// too few executions
+	q = antedecorators.NewContractQuarantine(testQuarantineConfig(antedecorators.ContractQuarantineReject))
+	recordExecutions(q, 1, honestContract, 3, 3, 1000)
+	require.False(t, q.IsQuarantined(honestContract))
+}
+
+func TestContractQuarantineWindowAndExpiry(t *testing.T) {
+	q := antedecorators.NewContractQuarantine(testQuarantineConfig(antedecorators.ContractQuarantineReject))
+
+	recordExecutions(q, 1, spamContract, 2, 2, 1000)
+	recordExecutions(q, 2, spamContract, 0, 0, 0)
+	recordExecutions(q, 3, spamContract, 0, 0, 0)
+	// height 1 has left the window by the time the next two reverts land
+	recordExecutions(q, 4, spamContract, 2, 2, 1000)
+	require.False(t, q.IsQuarantined(spamContract))
+
+	recordExecutions(q, 5, spamContract, 2, 2, 1000)
+	require.True(t, q.IsQuarantined(spamContract))
+	// height 6 still has heights 4 and 5 in its window and extends the
+	// quarantine through height 10
+	for height := int64(6); height <= 10; height++ {
+		recordExecutions(q, height, spamContract, 0, 0, 0)
+		require.True(t, q.IsQuarantined(spamContract))
+	}
+	recordExecutions(q, 11, spamContract, 0, 0, 0)
+	require.False(t, q.IsQuarantined(spamContract))
+}
+
+func TestContractQuarantineRecordsHeightOnce(t *testing.T) {
+	q := antedecorators.NewContractQuarantine(testQuarantineConfig(antedecorators.ContractQuarantineReject))
+
+	// an optimistically processed block is processed again when finalized
+	recordExecutions(q, 1, spamContract, 2, 2, 1000)
+	recordExecutions(q, 1, spamContract, 2, 2, 1000)
+	require.False(t, q.IsQuarantined(spamContract))
+}
+
+func TestContractQuarantineDisabled(t *testing.T) {
+	config := testQuarantineConfig(antedecorators.ContractQuarantineReject)
+	config.Enable = false
+	q := antedecorators.NewContractQuarantine(config)
+	recordExecutions(q, 1, spamContract, 10, 10, 1000)
+	require.False(t, q.IsQuarantined(spamContract))
+}
+
+func TestContractQuarantineDecorator(t *testing.T) {
+	for _, mode := range []string{antedecorators.ContractQuarantineReject, antedecorators.ContractQuarantineDeprioritize} {
+		q := antedecorators.NewContractQuarantine(testQuarantineConfig(mode))
+		recordExecutions(q, 1, spamContract, 10, 10, 1000)
+		require.True(t, q.IsQuarantined(spamContract))
+
+		chainedHandler, _ := sdk.ChainAnteDecorators(
+			sdk.DefaultWrappedAnteDecorator(antedecorators.NewContractQuarantineDecorator(q)),
+		)
+		ctx := sdk.NewContext(nil, tmproto.Header{}, true, nil).WithPriority(125)
+
+		newCtx, err := chainedHandler(ctx, executeTx(honestContract), false)
+		require.NoError(t, err)
+		require.Equal(t, int64(125), newCtx.Priority())
+
+		msgExec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&wasmtypes.MsgExecuteContract{Contract: spamContract}})
+		execTx := FakeTx{FakeMsgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Contract: honestContract}, &msgExec}}
+		for _, tx := range []sdk.Tx{executeTx(spamContract), execTx} {
+			newCtx, err = chainedHandler(ctx, tx, false)
+			if mode == antedecorators.ContractQuarantineReject {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, int64(0), newCtx.Priority())
+			}
+
+			// simulations and DeliverTx are never affected
+			newCtx, err = chainedHandler(ctx, tx, true)
+			require.NoError(t, err)
+			require.Equal(t, int64(125), newCtx.Priority())
+			newCtx, err = chainedHandler(ctx.WithIsCheckTx(false), tx, false)
+			require.NoError(t, err)
+			require.Equal(t, int64(125), newCtx.Priority())
+		}
+	}
+}
@@ -140,6 +140,20 @@ import (
 	tokenfactorykeeper "github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
 
+	blobmodule "github.com/sei-protocol/sei-chain/x/blob"
+	blobkeeper "github.com/sei-protocol/sei-chain/x/blob/keeper"
+	blobtypes "github.com/sei-protocol/sei-chain/x/blob/types"
+
+	seqhistorymodule "github.com/sei-protocol/sei-chain/x/seqhistory"
+	seqhistorykeeper "github.com/sei-protocol/sei-chain/x/seqhistory/keeper"
+	seqhistorytypes "github.com/sei-protocol/sei-chain/x/seqhistory/types"
+	taggingmodule "github.com/sei-protocol/sei-chain/x/tagging"
+	taggingkeeper "github.com/sei-protocol/sei-chain/x/tagging/keeper"
+	taggingtypes "github.com/sei-protocol/sei-chain/x/tagging/types"
+	watchtowermodule "github.com/sei-protocol/sei-chain/x/watchtower"
+	watchtowerkeeper "github.com/sei-protocol/sei-chain/x/watchtower/keeper"
+	watchtowertypes "github.com/sei-protocol/sei-chain/x/watchtower/types"
+
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -196,6 +210,10 @@ var (
 		dexmodule.AppModuleBasic{},
 		epochmodule.AppModuleBasic{},
 		tokenfactorymodule.AppModuleBasic{},
+		blobmodule.AppModuleBasic{},
+		taggingmodule.AppModuleBasic{},
+		watchtowermodule.AppModuleBasic{},
+		seqhistorymodule.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -213,6 +231,8 @@ var (
 		wasm.ModuleName:                {authtypes.Burner},
 		dexmoduletypes.ModuleName:      nil,
 		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		blobtypes.ModuleName:           {authtypes.Burner},
+		watchtowertypes.ModuleName:     nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -327,6 +347,14 @@ type App struct {
 
 	TokenFactoryKeeper tokenfactorykeeper.Keeper
 
+	BlobKeeper blobkeeper.Keeper
+
+	TaggingKeeper taggingkeeper.Keeper
+
+	WatchtowerKeeper watchtowerkeeper.Keeper
+
+	SeqHistoryKeeper seqhistorykeeper.Keeper
+
 	// mm is the module manager
 	mm *module.Manager
 
@@ -352,6 +380,10 @@ type App struct {
 	MemState                *dexcache.MemState
 
 	HardForkManager *upgrades.HardForkManager
+
+	CompactionScheduler *CompactionScheduler
+
+	ContractQuarantine *antedecorators.ContractQuarantine
 }
 
 // New returns a reference to an initialized blockchain app
@@ -389,6 +421,10 @@ func New(
 		dexmoduletypes.StoreKey,
 		epochmoduletypes.StoreKey,
 		tokenfactorytypes.StoreKey,
+		blobtypes.StoreKey,
+		taggingtypes.StoreKey,
+		watchtowertypes.StoreKey,
+		seqhistorytypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -407,6 +443,12 @@ func New(
 		versionInfo:       version.NewInfo(),
 		metricCounter:     &map[string]float32{},
 	}
+	app.CompactionScheduler = NewCompactionScheduler(db, appOpts)
+	contractQuarantineConfig := parseContractQuarantineConfig(appOpts)
+	if err := contractQuarantineConfig.Validate(); err != nil {
+		panic(err)
+	}
+	app.ContractQuarantine = antedecorators.NewContractQuarantine(contractQuarantineConfig)
 	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	// set the BaseApp's parameter store
@@ -520,6 +562,30 @@ func New(
 		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
 	)
+	app.BlobKeeper = blobkeeper.NewKeeper(
+		appCodec,
+		app.keys[blobtypes.StoreKey],
+		app.GetSubspace(blobtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+	app.TaggingKeeper = taggingkeeper.NewKeeper(
+		appCodec,
+		app.keys[taggingtypes.StoreKey],
+		app.GetSubspace(taggingtypes.ModuleName),
+	)
+	app.WatchtowerKeeper = watchtowerkeeper.NewKeeper(
+		appCodec,
+		app.keys[watchtowertypes.StoreKey],
+		app.GetSubspace(watchtowertypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+	app.SeqHistoryKeeper = seqhistorykeeper.NewKeeper(
+		appCodec,
+		app.keys[seqhistorytypes.StoreKey],
+		app.GetSubspace(seqhistorytypes.ModuleName),
+	)
 
 	customDependencyGenerators := aclmapping.NewCustomDependencyGenerator()
 	aclOpts = append(aclOpts, aclkeeper.WithDependencyGeneratorMappings(customDependencyGenerators.GetCustomDependencyGenerators()))
@@ -584,6 +650,7 @@ func New(
 		AddRoute(dexmoduletypes.RouterKey, dexmodule.NewProposalHandler(app.DexKeeper)).
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
+		AddRoute(taggingtypes.RouterKey, taggingmodule.NewProposalHandler(app.TaggingKeeper)).
 		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper))
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
@@ -639,6 +706,10 @@ func New(
 		dexModule,
 		epochModule,
 		tokenfactorymodule.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
+		blobmodule.NewAppModule(app.BlobKeeper),
+		taggingmodule.NewAppModule(app.TaggingKeeper),
+		watchtowermodule.NewAppModule(app.WatchtowerKeeper),
+		seqhistorymodule.NewAppModule(app.SeqHistoryKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
@@ -671,6 +742,10 @@ func New(
 		dexmoduletypes.ModuleName,
 		wasm.ModuleName,
 		tokenfactorytypes.ModuleName,
+		blobtypes.ModuleName,
+		taggingtypes.ModuleName,
+		watchtowertypes.ModuleName,
+		seqhistorytypes.ModuleName,
 		acltypes.ModuleName,
 	)
 
@@ -702,6 +777,10 @@ func New(
 		dexmoduletypes.ModuleName,
 		wasm.ModuleName,
 		tokenfactorytypes.ModuleName,
+		blobtypes.ModuleName,
+		taggingtypes.ModuleName,
+		watchtowertypes.ModuleName,
+		seqhistorytypes.ModuleName,
 		acltypes.ModuleName,
 	)
 
@@ -725,6 +804,8 @@ func New(
 		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		dexmoduletypes.ModuleName,
+		// tagging params are read by the ante handler when gentxs are delivered
+		taggingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -734,12 +815,16 @@ func New(
 		tokenfactorytypes.ModuleName,
 		epochmoduletypes.ModuleName,
 		wasm.ModuleName,
+		blobtypes.ModuleName,
+		watchtowertypes.ModuleName,
+		seqhistorytypes.ModuleName,
 		acltypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
+	app.QueryRouter().AddRoute(CompactionQueryRoute, app.CompactionScheduler.Querier)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
@@ -763,6 +848,10 @@ func New(
 		dexModule,
 		epochModule,
 		tokenfactorymodule.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
+		blobmodule.NewAppModule(app.BlobKeeper),
+		taggingmodule.NewAppModule(app.TaggingKeeper),
+		watchtowermodule.NewAppModule(app.WatchtowerKeeper),
+		seqhistorymodule.NewAppModule(app.SeqHistoryKeeper),
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 	app.sm.RegisterStoreDecoders()
@@ -806,9 +895,11 @@ func New(
 			WasmKeeper:          &app.WasmKeeper,
 			OracleKeeper:        &app.OracleKeeper,
 			DexKeeper:           &app.DexKeeper,
+			TaggingKeeper:       &app.TaggingKeeper,
 			TracingInfo:         app.GetBaseApp().TracingInfo,
 			AccessControlKeeper: &app.AccessControlKeeper,
 			CheckTxMemState:     app.CheckTxMemState,
+			ContractQuarantine:  app.ContractQuarantine,
 		},
 	)
 	if err != nil {
@@ -916,6 +1007,15 @@ func (app *App) SetStoreUpgradeHandlers() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	if upgradeInfo.Name == "v3.6.0" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{blobtypes.StoreKey, taggingtypes.StoreKey, watchtowertypes.StoreKey, seqhistorytypes.StoreKey},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 // AppName returns the name of the App
@@ -931,7 +1031,9 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	if app.HardForkManager.TargetHeightReached(ctx) {
 		app.HardForkManager.ExecuteForTargetHeight(ctx)
 	}
-	return app.mm.BeginBlock(ctx, req)
+	resp := app.mm.BeginBlock(ctx, req)
+	app.CompactionScheduler.OnBlock(ctx, app)
+	return resp
 }
 
 // MidBlocker application updates every mid block
@@ -1286,10 +1388,13 @@ func (app *App) PartitionPrioritizedTxs(ctx sdk.Context, txs [][]byte) (prioriti
 	return prioritizedTxs, otherTxs, prioritizedIndices, otherIndices
 }
 
-func (app *App) BuildDependenciesAndRunTxs(ctx sdk.Context, txs [][]byte) ([]*abci.ExecTxResult, sdk.Context) {
+// BuildDependenciesAndRunTxs runs the txs and returns their results along with
+// the decoded txs, which are nil for txs that fail to decode
+func (app *App) BuildDependenciesAndRunTxs(ctx sdk.Context, txs [][]byte) ([]*abci.ExecTxResult, []sdk.Tx, sdk.Context) {
 	var txResults []*abci.ExecTxResult
 
-	dependencyDag, err := app.AccessControlKeeper.BuildDependencyDag(ctx, app.txDecoder, app.GetAnteDepGenerator(), txs)
+	typedTxs := app.decodeTxs(txs)
+	dependencyDag, err := app.AccessControlKeeper.BuildDependencyDag(ctx, app.decodedTxDecoder(txs, typedTxs), app.GetAnteDepGenerator(), txs)
 
 	switch err {
 	case nil:
@@ -1304,7 +1409,35 @@ func (app *App) BuildDependenciesAndRunTxs(ctx sdk.Context, txs [][]byte) ([]*ab
 		metrics.IncrDagBuildErrorCounter(metrics.FailedToBuild)
 	}
 
-	return txResults, ctx
+	return txResults, typedTxs, ctx
+}
+
+// decodeTxs decodes each of the txs, leaving nil for txs that fail to decode
+func (app *App) decodeTxs(txs [][]byte) []sdk.Tx {
+	typedTxs := make([]sdk.Tx, len(txs))
+	for i, tx := range txs {
+		if typedTx, err := app.txDecoder(tx); err == nil {
+			typedTxs[i] = typedTx
+		}
+	}
+	return typedTxs
+}
+
+// decodedTxDecoder returns a decoder that serves the already decoded typedTxs
+// of txs by their bytes and only decodes bytes it hasn't seen
+func (app *App) decodedTxDecoder(txs [][]byte, typedTxs []sdk.Tx) sdk.TxDecoder {
+	decoded := make(map[string]sdk.Tx, len(txs))
+	for i, tx := range typedTxs {
+		if tx != nil {
+			decoded[string(txs[i])] = tx
+		}
+	}
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if tx, ok := decoded[string(txBytes)]; ok {
+			return tx, nil
+		}
+		return app.txDecoder(txBytes)
+	}
 }
 
 func (app *App) ProcessBlock(ctx sdk.Context, txs [][]byte, req BlockProcessRequest, lastCommit abci.CommitInfo) ([]abci.Event, []*abci.ExecTxResult, abci.ResponseEndBlock, error) {
@@ -1338,12 +1471,14 @@ func (app *App) ProcessBlock(ctx sdk.Context, txs [][]byte, req BlockProcessRequ
 	events = append(events, beginBlockResp.Events...)
 
 	txResults := make([]*abci.ExecTxResult, len(txs))
+	typedTxs := make([]sdk.Tx, len(txs))
 	prioritizedTxs, otherTxs, prioritizedIndices, otherIndices := app.PartitionPrioritizedTxs(ctx, txs)
 
 	// run the prioritized txs
-	prioritizedResults, ctx := app.BuildDependenciesAndRunTxs(ctx, prioritizedTxs)
+	prioritizedResults, prioritizedTypedTxs, ctx := app.BuildDependenciesAndRunTxs(ctx, prioritizedTxs)
 	for relativePrioritizedIndex, originalIndex := range prioritizedIndices {
 		txResults[originalIndex] = prioritizedResults[relativePrioritizedIndex]
+		typedTxs[originalIndex] = prioritizedTypedTxs[relativePrioritizedIndex]
 	}
 
 	// Finalize all Bank Module Transfers here so that events are included for prioritiezd txs
@@ -1353,9 +1488,10 @@ func (app *App) ProcessBlock(ctx sdk.Context, txs [][]byte, req BlockProcessRequ
 	midBlockEvents := app.MidBlock(ctx, req.GetHeight())
 	events = append(events, midBlockEvents...)
 
-	otherResults, ctx := app.BuildDependenciesAndRunTxs(ctx, otherTxs)
+	otherResults, otherTypedTxs, ctx := app.BuildDependenciesAndRunTxs(ctx, otherTxs)
 	for relativeOtherIndex, originalIndex := range otherIndices {
 		txResults[originalIndex] = otherResults[relativeOtherIndex]
+		typedTxs[originalIndex] = otherTypedTxs[relativeOtherIndex]
 	}
 
 	// Finalize all Bank Module Transfers here so that events are included
@@ -1366,10 +1502,50 @@ func (app *App) ProcessBlock(ctx sdk.Context, txs [][]byte, req BlockProcessRequ
 		Height: req.GetHeight(),
 	})
 
+	app.recordEpochUsage(ctx, typedTxs, txResults)
+	app.ContractQuarantine.RecordBlock(req.GetHeight(), typedTxs, txResults)
+	app.recordSequenceHistory(ctx, txs, txResults)
+
 	events = append(events, endBlockResp.Events...)
 	return events, txResults, endBlockResp, nil
 }
 
+// recordEpochUsage adds the block's tx count, gas used, fees and fee payers to
+// the running usage of the current epoch. It runs after EndBlock so that the
+// fee collector holds both this block's tx fees and the dex rent charged in
+// EndBlock. Distribution sweeps the fee collector in BeginBlock, after the
+// epoch hooks mint into it, so its balance is exactly what this block added.
+// typedTxs are nil for txs that failed to decode, which aren't counted.
+func (app *App) recordEpochUsage(ctx sdk.Context, typedTxs []sdk.Tx, txResults []*abci.ExecTxResult) {
+	var txCount, gasUsed uint64
+	senders := []sdk.AccAddress{}
+	for i, tx := range typedTxs {
+		if tx == nil || i >= len(txResults) || txResults[i] == nil {
+			continue
+		}
+		txCount++
+		if txResults[i].GasUsed > 0 {
+			gasUsed += uint64(txResults[i].GasUsed)
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			senders = append(senders, feeTx.FeePayer())
+		}
+	}
+	fees := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	app.EpochKeeper.RecordBlockUsage(ctx, txCount, gasUsed, fees, app.BlobKeeper.GetBlockFeesBurned(ctx), senders)
+}
+
+// recordSequenceHistory records the account sequences each of the block's txs
+// consumed
+func (app *App) recordSequenceHistory(ctx sdk.Context, txs [][]byte, txResults []*abci.ExecTxResult) {
+	for i, txResult := range txResults {
+		if txResult == nil {
+			continue
+		}
+		app.SeqHistoryKeeper.RecordTxSequences(ctx, seqhistorytypes.TxHash(txs[i]), txResult.Events)
+	}
+}
+
 func (app *App) addBadWasmDependenciesToContext(ctx sdk.Context, txResults []*abci.ExecTxResult) sdk.Context {
 	wasmContractsWithIncorrectDependencies := []sdk.AccAddress{}
 	for _, txResult := range txResults {
@@ -1574,6 +1750,10 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(dexmoduletypes.ModuleName)
 	paramsKeeper.Subspace(epochmoduletypes.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(blobtypes.ModuleName)
+	paramsKeeper.Subspace(taggingtypes.ModuleName)
+	paramsKeeper.Subspace(watchtowertypes.ModuleName)
+	paramsKeeper.Subspace(seqhistorytypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	return paramsKeeper
@@ -328,3 +328,43 @@ func TestInvalidProposalWithExcessiveGasWanted(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
 }
+
+func TestBuildDependenciesAndRunTxsReturnsTypedTxs(t *testing.T) {
+	tm := time.Now().UTC()
+	valPub := secp256k1.GenPrivKey().PubKey()
+	secondAcc := secp256k1.GenPrivKey().PubKey()
+
+	testWrapper := app.NewTestWrapper(t, tm, valPub)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(valPub.Address()).String(),
+		ToAddress:   sdk.AccAddress(secondAcc.Address()).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("usei", 2)),
+	}
+	txBuilder := app.MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(sendMsg))
+	sendTx, err := app.MakeEncodingConfig().TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	ctx := testWrapper.Ctx.WithBlockHeight(1).WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	for _, tc := range []struct {
+		txs     [][]byte
+		decoded []bool
+	}{
+		// the same tx bytes twice
+		{txs: [][]byte{sendTx, sendTx}, decoded: []bool{true, true}},
+		// a tx that doesn't decode fails the DAG and runs the block synchronously
+		{txs: [][]byte{sendTx, []byte("not a tx"), sendTx}, decoded: []bool{true, false, true}},
+	} {
+		txResults, typedTxs, _ := testWrapper.App.BuildDependenciesAndRunTxs(ctx, tc.txs)
+		require.Len(t, txResults, len(tc.txs))
+		require.Len(t, typedTxs, len(tc.txs))
+		for i, typedTx := range typedTxs {
+			if !tc.decoded[i] {
+				require.Nil(t, typedTx)
+				continue
+			}
+			require.Equal(t, []sdk.Msg{sendMsg}, typedTx.GetMsgs())
+		}
+	}
+}
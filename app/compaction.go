@@ -0,0 +1,143 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	leveldbutils "github.com/syndtr/goleveldb/leveldb/util"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	FlagCompactionEnable      = "compaction.enable"
+	FlagCompactionMaxEpochTxs = "compaction.max-epoch-txs"
+
+	// CompactionQueryRoute is the custom query route the compaction status is
+	// served under, i.e. custom/compaction/status
+	CompactionQueryRoute  = "compaction"
+	QueryCompactionStatus = "status"
+)
+
+var ErrCompactionUnsupported = errors.New("only goleveldb application DBs can be compacted")
+
+// CompactionStatus reports the node's background compactions of the
+// application DB
+type CompactionStatus struct {
+	Enabled     bool      `json:"enabled"`
+	Supported   bool      `json:"supported"`
+	MaxEpochTxs uint64    `json:"max_epoch_txs"`
+	Running     bool      `json:"running"`
+	Count       uint64    `json:"count"`
+	LastEpoch   uint64    `json:"last_epoch"`
+	LastStart   time.Time `json:"last_start"`
+	LastSeconds float64   `json:"last_seconds"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// CompactionScheduler compacts the application DB in the background after an
+// epoch whose tx count is at most MaxEpochTxs, so the compaction's IO happens
+// while traffic is low instead of whenever the DB decides to. It's node-local:
+// it never touches state and nodes are free to configure it differently.
+type CompactionScheduler struct {
+	mtx         sync.Mutex
+	status      CompactionStatus
+	initialized bool
+	lastEpoch   uint64
+	compact     func() error
+}
+
+// NewCompactionScheduler returns a scheduler for db configured from app.toml.
+// It is disabled unless db is a goleveldb DB.
+func NewCompactionScheduler(db dbm.DB, appOpts servertypes.AppOptions) *CompactionScheduler {
+	compact := func() error { return ErrCompactionUnsupported }
+	goleveldb, supported := db.(*dbm.GoLevelDB)
+	if supported {
+		compact = func() error {
+			return goleveldb.DB().CompactRange(leveldbutils.Range{Start: nil, Limit: nil})
+		}
+	}
+	return newCompactionScheduler(
+		cast.ToBool(appOpts.Get(FlagCompactionEnable)),
+		supported,
+		cast.ToUint64(appOpts.Get(FlagCompactionMaxEpochTxs)),
+		compact,
+	)
+}
+
+func newCompactionScheduler(enabled bool, supported bool, maxEpochTxs uint64, compact func() error) *CompactionScheduler {
+	return &CompactionScheduler{
+		status: CompactionStatus{
+			Enabled:     enabled,
+			Supported:   supported,
+			MaxEpochTxs: maxEpochTxs,
+		},
+		compact: compact,
+	}
+}
+
+// OnBlock starts a compaction if an epoch ended since the last block and its
+// usage report shows little traffic. A compaction that is still running is
+// never doubled up. The first block after startup only records the epoch.
+func (s *CompactionScheduler) OnBlock(ctx sdk.Context, app *App) {
+	currentEpoch := app.EpochKeeper.GetEpoch(ctx).CurrentEpoch
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if !s.initialized || currentEpoch == s.lastEpoch {
+		s.initialized = true
+		s.lastEpoch = currentEpoch
+		return
+	}
+	finishedEpoch := s.lastEpoch
+	s.lastEpoch = currentEpoch
+	if !s.status.Enabled || !s.status.Supported || s.status.Running {
+		return
+	}
+	usage, found := app.EpochKeeper.GetEpochUsage(ctx, finishedEpoch)
+	if !found || usage.TxCount > s.status.MaxEpochTxs {
+		return
+	}
+
+	ctx.Logger().Info("starting background compaction of the application DB", "epoch", finishedEpoch, "txs", usage.TxCount)
+	s.status.Running = true
+	s.status.LastEpoch = finishedEpoch
+	s.status.LastStart = time.Now()
+	go s.run(ctx)
+}
+
+func (s *CompactionScheduler) run(ctx sdk.Context) {
+	err := s.compact()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.status.Running = false
+	s.status.Count++
+	s.status.LastSeconds = time.Since(s.status.LastStart).Seconds()
+	s.status.LastError = ""
+	if err != nil {
+		s.status.LastError = err.Error()
+		ctx.Logger().Error("background compaction of the application DB failed", "error", err)
+		return
+	}
+	ctx.Logger().Info("finished background compaction of the application DB", "seconds", s.status.LastSeconds)
+}
+
+// Status returns a snapshot of the scheduler's state
+func (s *CompactionScheduler) Status() CompactionStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.status
+}
+
+// Querier serves the compaction status on the custom/compaction route
+func (s *CompactionScheduler) Querier(_ sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	if len(path) == 0 || path[0] != QueryCompactionStatus {
+		return nil, errors.New("unknown compaction query endpoint")
+	}
+	return json.Marshal(s.Status())
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func setCompactionTestEpoch(ctx sdk.Context, app *App, current uint64) {
+	epoch := app.EpochKeeper.GetEpoch(ctx)
+	epoch.CurrentEpoch = current
+	app.EpochKeeper.SetEpoch(ctx, epoch)
+}
+
+func waitForCompaction(t *testing.T, scheduler *CompactionScheduler) CompactionStatus {
+	require.Eventually(t, func() bool { return !scheduler.Status().Running }, time.Second, time.Millisecond)
+	return scheduler.Status()
+}
+
+func TestCompactionSchedulerStartsAfterQuietEpoch(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.EpochKeeper.SetEpochUsage(ctx, epochtypes.EpochUsage{Epoch: 1, TxCount: 10, FeesCollected: sdk.NewCoins()})
+	app.EpochKeeper.SetEpochUsage(ctx, epochtypes.EpochUsage{Epoch: 2, TxCount: 11, FeesCollected: sdk.NewCoins()})
+
+	compactions := 0
+	scheduler := newCompactionScheduler(true, true, 10, func() error {
+		compactions++
+		return nil
+	})
+
+	// the first block only records the epoch
+	setCompactionTestEpoch(ctx, app, 1)
+	scheduler.OnBlock(ctx, app)
+	require.Equal(t, uint64(0), waitForCompaction(t, scheduler).Count)
+
+	// epoch 1 had 10 txs
+	setCompactionTestEpoch(ctx, app, 2)
+	scheduler.OnBlock(ctx, app)
+	status := waitForCompaction(t, scheduler)
+	require.Equal(t, uint64(1), status.Count)
+	require.Equal(t, uint64(1), status.LastEpoch)
+	require.Empty(t, status.LastError)
+
+	// no new epoch
+	scheduler.OnBlock(ctx, app)
+	require.Equal(t, uint64(1), waitForCompaction(t, scheduler).Count)
+
+	// epoch 2 had 11 txs
+	setCompactionTestEpoch(ctx, app, 3)
+	scheduler.OnBlock(ctx, app)
+	require.Equal(t, uint64(1), waitForCompaction(t, scheduler).Count)
+	require.Equal(t, 1, compactions)
+}
+
+func TestCompactionSchedulerDoesNotOverlap(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.EpochKeeper.SetEpochUsage(ctx, epochtypes.EpochUsage{Epoch: 1, FeesCollected: sdk.NewCoins()})
+	app.EpochKeeper.SetEpochUsage(ctx, epochtypes.EpochUsage{Epoch: 2, FeesCollected: sdk.NewCoins()})
+
+	release := make(chan struct{})
+	scheduler := newCompactionScheduler(true, true, 10, func() error {
+		<-release
+		return errors.New("compaction failed")
+	})
+	setCompactionTestEpoch(ctx, app, 1)
+	scheduler.OnBlock(ctx, app)
+	setCompactionTestEpoch(ctx, app, 2)
+	scheduler.OnBlock(ctx, app)
+	require.True(t, scheduler.Status().Running)
+
+	// epoch 2 ends while epoch 1's compaction is still running
+	setCompactionTestEpoch(ctx, app, 3)
+	scheduler.OnBlock(ctx, app)
+	close(release)
+	status := waitForCompaction(t, scheduler)
+	require.Equal(t, uint64(1), status.Count)
+	require.Equal(t, uint64(1), status.LastEpoch)
+	require.Equal(t, "compaction failed", status.LastError)
+}
+
+func TestCompactionSchedulerDisabled(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.EpochKeeper.SetEpochUsage(ctx, epochtypes.EpochUsage{Epoch: 1, FeesCollected: sdk.NewCoins()})
+
+	for _, scheduler := range []*CompactionScheduler{
+		newCompactionScheduler(false, true, 10, func() error { return nil }),
+		newCompactionScheduler(true, false, 10, func() error { return ErrCompactionUnsupported }),
+	} {
+		setCompactionTestEpoch(ctx, app, 1)
+		scheduler.OnBlock(ctx, app)
+		setCompactionTestEpoch(ctx, app, 2)
+		scheduler.OnBlock(ctx, app)
+		status := waitForCompaction(t, scheduler)
+		require.Equal(t, uint64(0), status.Count)
+		require.Empty(t, status.LastError)
+	}
+}
+
+func TestCompactionSchedulerQuerier(t *testing.T) {
+	scheduler := newCompactionScheduler(true, true, 10, func() error { return nil })
+
+	res, err := scheduler.Querier(sdk.Context{}, []string{QueryCompactionStatus}, abci.RequestQuery{})
+	require.NoError(t, err)
+	status := CompactionStatus{}
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.Equal(t, scheduler.Status(), status)
+
+	_, err = scheduler.Querier(sdk.Context{}, []string{"unknown"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/sei-protocol/sei-chain/app/antedecorators"
+	"github.com/spf13/cast"
+)
+
+const (
+	FlagContractQuarantineEnable           = "contract-quarantine.enable"
+	FlagContractQuarantineMode             = "contract-quarantine.mode"
+	FlagContractQuarantineWindowBlocks     = "contract-quarantine.window-blocks"
+	FlagContractQuarantineMinExecutions    = "contract-quarantine.min-executions"
+	FlagContractQuarantineMaxRevertRate    = "contract-quarantine.max-revert-rate"
+	FlagContractQuarantineMaxWastedGas     = "contract-quarantine.max-wasted-gas"
+	FlagContractQuarantineQuarantineBlocks = "contract-quarantine.quarantine-blocks"
+)
+
+func parseContractQuarantineConfig(appOpts servertypes.AppOptions) antedecorators.ContractQuarantineConfig {
+	return antedecorators.ContractQuarantineConfig{
+		Enable:           cast.ToBool(appOpts.Get(FlagContractQuarantineEnable)),
+		Mode:             cast.ToString(appOpts.Get(FlagContractQuarantineMode)),
+		WindowBlocks:     cast.ToInt64(appOpts.Get(FlagContractQuarantineWindowBlocks)),
+		MinExecutions:    cast.ToUint64(appOpts.Get(FlagContractQuarantineMinExecutions)),
+		MaxRevertRate:    cast.ToFloat64(appOpts.Get(FlagContractQuarantineMaxRevertRate)),
+		MaxWastedGas:     cast.ToUint64(appOpts.Get(FlagContractQuarantineMaxWastedGas)),
+		QuarantineBlocks: cast.ToInt64(appOpts.Get(FlagContractQuarantineQuarantineBlocks)),
+	}
+}
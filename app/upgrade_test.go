@@ -5,9 +5,14 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/sei-protocol/sei-chain/app"
+	blobtypes "github.com/sei-protocol/sei-chain/x/blob/types"
+	seqhistorytypes "github.com/sei-protocol/sei-chain/x/seqhistory/types"
+	taggingtypes "github.com/sei-protocol/sei-chain/x/tagging/types"
+	watchtowertypes "github.com/sei-protocol/sei-chain/x/watchtower/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -86,3 +91,28 @@ func TestSkipOptimisticProcessingOnUpgrade(t *testing.T) {
 		require.False(t, testWrapper.App.GetOptimisticProcessingInfo().Aborted)
 	})
 }
+
+// An existing chain has no version for the modules added in v3.6.0, so the
+// upgrade must run their InitGenesis
+func TestNewModulesInitializedOnUpgrade(t *testing.T) {
+	tm := time.Now().UTC()
+	valPub := secp256k1.GenPrivKey().PubKey()
+	testWrapper := app.NewTestWrapper(t, tm, valPub)
+	testWrapper.App.RegisterUpgradeHandlers()
+	require.True(t, testWrapper.App.UpgradeKeeper.HasHandler("v3.6.0"))
+
+	ctx := testWrapper.Ctx
+	versionStore := prefix.NewStore(ctx.KVStore(testWrapper.App.GetKey(types.StoreKey)), []byte{types.VersionMapByte})
+	for _, name := range []string{blobtypes.ModuleName, taggingtypes.ModuleName, watchtowertypes.ModuleName, seqhistorytypes.ModuleName} {
+		versionStore.Delete([]byte(name))
+	}
+	testWrapper.App.TaggingKeeper.SetParams(ctx, taggingtypes.Params{RejectTaggedTxs: true})
+
+	testWrapper.App.UpgradeKeeper.ApplyUpgrade(ctx, types.Plan{Name: "v3.6.0", Height: ctx.BlockHeight()})
+
+	require.Equal(t, taggingtypes.DefaultParams(), testWrapper.App.TaggingKeeper.GetParams(ctx))
+	vm := testWrapper.App.UpgradeKeeper.GetModuleVersionMap(ctx)
+	for _, name := range []string{blobtypes.ModuleName, taggingtypes.ModuleName, watchtowertypes.ModuleName, seqhistorytypes.ModuleName} {
+		require.Contains(t, vm, name)
+	}
+}
@@ -65,6 +65,8 @@ var upgradesList = []string{
 	"v3.2.1",
 	"v3.3.0",
 	"v3.5.0",
+	// adds the blob, tagging, watchtower and seqhistory modules
+	"v3.6.0",
 }
 
 // if there is an override list, use that instead, for integration tests
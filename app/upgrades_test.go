@@ -10,6 +10,7 @@ import (
 
 func TestOverrideList(t *testing.T) {
 	defaultList := upgradesList
+	defer func() { upgradesList = defaultList }()
 	tests := []struct {
 		name         string
 		envValue     string
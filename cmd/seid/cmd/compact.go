@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/app"
 	"github.com/sei-protocol/sei-chain/app/params"
 	"github.com/spf13/cobra"
 	leveldbutils "github.com/syndtr/goleveldb/leveldb/util"
@@ -51,3 +53,28 @@ func CompactCmd(defaultNodeHome string) *cobra.Command {
 
 	return cmd
 }
+
+func CompactionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compaction-status",
+		Short: "Query the node's background compactions of the application DB",
+		Long:  `Query the node's background compactions of the application DB, which are scheduled after low-traffic epochs when compaction.enable is set in app.toml`,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", app.CompactionQueryRoute, app.QueryCompactionStatus)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(res) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -79,6 +79,11 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 
 			// Override default config.toml values with optimized values
 			params.SetTendermintConfigs(config)
+			nodeMode, err := getNodeMode(cmd)
+			if err != nil {
+				return err
+			}
+			applyNodeModeTendermintConfig(nodeMode, config)
 
 			config.SetRoot(clientCtx.HomeDir)
 			configPath := filepath.Join(config.RootDir, "config")
@@ -163,6 +168,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd.Flags().BoolP(FlagOverwrite, "o", false, "overwrite the genesis.json file")
 	cmd.Flags().Bool(FlagRecover, false, "provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will use sei")
+	cmd.Flags().String(FlagNodeMode, NodeModePruned, "retention profile for generated config files (pruned|archive|light-rpc); an existing app.toml is not rewritten")
 
 	return cmd
 }
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+)
+
+const (
+	// FlagNodeMode selects the retention profile used when generating config files.
+	FlagNodeMode = "node-mode"
+
+	// NodeModePruned keeps recent state only and is the default.
+	NodeModePruned = "pruned"
+	// NodeModeArchive keeps every historical state version, block and tx index entry.
+	NodeModeArchive = "archive"
+	// NodeModeLightRPC keeps a short window of state and blocks but indexes it to serve RPC.
+	NodeModeLightRPC = "light-rpc"
+
+	// lightRPCRetention is the number of recent heights a light-rpc node keeps.
+	lightRPCRetention = 10000
+)
+
+// lightRPCIndexEvents are the events a light-rpc node indexes, enough to look
+// up txs by sender, action, transfer and contract.
+var lightRPCIndexEvents = []string{
+	"message.sender",
+	"message.action",
+	"transfer.sender",
+	"transfer.recipient",
+	"wasm._contract_address",
+}
+
+// getNodeMode returns the node mode requested on the command line. Commands
+// that do not define the flag always use the pruned profile.
+func getNodeMode(cmd *cobra.Command) (string, error) {
+	if cmd.Flags().Lookup(FlagNodeMode) == nil {
+		return NodeModePruned, nil
+	}
+	mode, err := cmd.Flags().GetString(FlagNodeMode)
+	if err != nil {
+		return "", err
+	}
+	switch mode {
+	case NodeModePruned, NodeModeArchive, NodeModeLightRPC:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %s %q, must be one of %s, %s or %s", FlagNodeMode, mode, NodeModePruned, NodeModeArchive, NodeModeLightRPC)
+	}
+}
+
+// applyNodeModeAppConfig sets the app.toml state pruning, state store, block
+// retention and event indexing options for the given node mode. Pruned nodes
+// keep the stock settings.
+func applyNodeModeAppConfig(mode string, srvCfg *serverconfig.Config) {
+	switch mode {
+	case NodeModeArchive:
+		srvCfg.Pruning = storetypes.PruningOptionNothing
+		srvCfg.MinRetainBlocks = 0
+		srvCfg.IndexEvents = []string{}
+		srvCfg.StateCommit.Enable = true
+		srvCfg.StateStore.Enable = true
+		srvCfg.StateStore.KeepRecent = 0
+	case NodeModeLightRPC:
+		srvCfg.Pruning = storetypes.PruningOptionCustom
+		srvCfg.PruningKeepRecent = fmt.Sprintf("%d", lightRPCRetention)
+		srvCfg.PruningKeepEvery = "0"
+		srvCfg.MinRetainBlocks = lightRPCRetention
+		srvCfg.IndexEvents = lightRPCIndexEvents
+		srvCfg.StateCommit.Enable = true
+		srvCfg.StateStore.Enable = true
+		srvCfg.StateStore.KeepRecent = lightRPCRetention
+	}
+}
+
+// applyNodeModeTendermintConfig sets the config.toml tx indexing options for
+// the given node mode. Pruned nodes keep the stock settings.
+func applyNodeModeTendermintConfig(mode string, config *tmcfg.Config) {
+	switch mode {
+	case NodeModeArchive, NodeModeLightRPC:
+		config.Mode = tmcfg.ModeFull
+		config.TxIndex.Indexer = []string{"kv"}
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/sei-protocol/sei-chain/app/params"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+)
+
+func TestGetNodeMode(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		args     []string
+		withFlag bool
+		expected string
+		valid    bool
+	}{
+		{desc: "no flag defined", withFlag: false, expected: NodeModePruned, valid: true},
+		{desc: "flag default", withFlag: true, expected: NodeModePruned, valid: true},
+		{desc: "archive", withFlag: true, args: []string{"--node-mode=archive"}, expected: NodeModeArchive, valid: true},
+		{desc: "light-rpc", withFlag: true, args: []string{"--node-mode=light-rpc"}, expected: NodeModeLightRPC, valid: true},
+		{desc: "unknown mode", withFlag: true, args: []string{"--node-mode=full"}, valid: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			if tc.withFlag {
+				cmd.Flags().String(FlagNodeMode, NodeModePruned, "")
+			}
+			require.NoError(t, cmd.Flags().Parse(tc.args))
+
+			mode, err := getNodeMode(cmd)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, mode)
+		})
+	}
+}
+
+func TestApplyNodeModeAppConfig(t *testing.T) {
+	defaults := serverconfig.DefaultConfig()
+	for _, tc := range []struct {
+		mode              string
+		pruning           string
+		pruningKeepRecent string
+		minRetainBlocks   uint64
+		indexEvents       []string
+		stateStoreEnabled bool
+		stateStoreKeep    int
+	}{
+		{
+			mode:              NodeModePruned,
+			pruning:           defaults.Pruning,
+			pruningKeepRecent: defaults.PruningKeepRecent,
+			minRetainBlocks:   defaults.MinRetainBlocks,
+			indexEvents:       defaults.IndexEvents,
+			stateStoreEnabled: false,
+			stateStoreKeep:    defaults.StateStore.KeepRecent,
+		},
+		{
+			mode:              NodeModeArchive,
+			pruning:           storetypes.PruningOptionNothing,
+			pruningKeepRecent: defaults.PruningKeepRecent,
+			minRetainBlocks:   0,
+			indexEvents:       []string{},
+			stateStoreEnabled: true,
+			stateStoreKeep:    0,
+		},
+		{
+			mode:              NodeModeLightRPC,
+			pruning:           storetypes.PruningOptionCustom,
+			pruningKeepRecent: "10000",
+			minRetainBlocks:   lightRPCRetention,
+			indexEvents:       lightRPCIndexEvents,
+			stateStoreEnabled: true,
+			stateStoreKeep:    lightRPCRetention,
+		},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			srvCfg := serverconfig.DefaultConfig()
+			applyNodeModeAppConfig(tc.mode, srvCfg)
+
+			require.Equal(t, tc.pruning, srvCfg.Pruning)
+			require.Equal(t, tc.pruningKeepRecent, srvCfg.PruningKeepRecent)
+			require.Equal(t, tc.minRetainBlocks, srvCfg.MinRetainBlocks)
+			require.Equal(t, tc.indexEvents, srvCfg.IndexEvents)
+			require.Equal(t, tc.stateStoreEnabled, srvCfg.StateStore.Enable)
+			// the state store needs the state commit store
+			require.Equal(t, tc.stateStoreEnabled, srvCfg.StateCommit.Enable)
+			require.Equal(t, tc.stateStoreKeep, srvCfg.StateStore.KeepRecent)
+		})
+	}
+}
+
+func TestApplyNodeModeTendermintConfig(t *testing.T) {
+	for _, tc := range []struct {
+		mode    string
+		indexer []string
+	}{
+		{mode: NodeModePruned, indexer: tmcfg.DefaultConfig().TxIndex.Indexer},
+		{mode: NodeModeArchive, indexer: []string{"kv"}},
+		{mode: NodeModeLightRPC, indexer: []string{"kv"}},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			config := tmcfg.DefaultConfig()
+			applyNodeModeTendermintConfig(tc.mode, config)
+			require.Equal(t, tc.indexer, config.TxIndex.Indexer)
+		})
+	}
+}
+
+// A plain seid init must keep indexing txs like it did before node modes
+func TestInitDefaultNodeModeKeepsIndexer(t *testing.T) {
+	cmd := InitCmd(nil, t.TempDir())
+	mode, err := getNodeMode(cmd)
+	require.NoError(t, err)
+
+	config := tmcfg.DefaultConfig()
+	params.SetTendermintConfigs(config)
+	indexer := config.TxIndex.Indexer
+	applyNodeModeTendermintConfig(mode, config)
+	require.Equal(t, indexer, config.TxIndex.Indexer)
+}
+
+// The SeiDB settings only take effect if app.toml has a section for them
+func TestAppConfigTemplateRendersStateStore(t *testing.T) {
+	customAppTemplate, customAppConfig := initAppConfig(NodeModeLightRPC)
+	tmpl, err := template.New("appConfigFileTemplate").Parse(customAppTemplate)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buffer, customAppConfig))
+	require.Contains(t, buffer.String(), "ss-enable = true")
+	require.Contains(t, buffer.String(), "ss-keep-recent = 10000")
+}
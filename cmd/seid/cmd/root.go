@@ -35,8 +35,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/app/antedecorators"
 	"github.com/sei-protocol/sei-chain/app/params"
 	"github.com/sei-protocol/sei-chain/tools"
+	seidbconfig "github.com/sei-protocol/sei-db/config"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	tmcfg "github.com/tendermint/tendermint/config"
@@ -92,7 +94,11 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
-			customAppTemplate, customAppConfig := initAppConfig()
+			nodeMode, err := getNodeMode(cmd)
+			if err != nil {
+				return err
+			}
+			customAppTemplate, customAppConfig := initAppConfig(nodeMode)
 
 			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig)
 		},
@@ -177,6 +183,7 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		CompactionStatusCmd(),
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
@@ -348,7 +355,8 @@ func getPrimeNums(lo int, hi int) []int {
 
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
-func initAppConfig() (string, interface{}) {
+// The node mode selects the pruning, retention and indexing defaults.
+func initAppConfig(nodeMode string) (string, interface{}) {
 	// The following code snippet is just for reference.
 
 	// WASMConfig defines configuration for the wasm module.
@@ -360,10 +368,35 @@ func initAppConfig() (string, interface{}) {
 		LruSize uint64 `mapstructure:"lru_size"`
 	}
 
+	// CompactionConfig defines configuration for the background compactions of
+	// the application DB.
+	type CompactionConfig struct {
+		// Enable starts a compaction after every epoch with at most MaxEpochTxs txs
+		Enable bool `mapstructure:"enable"`
+
+		MaxEpochTxs uint64 `mapstructure:"max-epoch-txs"`
+	}
+
+	// ContractQuarantineConfig defines configuration for the node-local CheckTx
+	// quarantine of wasm contracts whose recent executions mostly revert.
+	type ContractQuarantineConfig struct {
+		Enable           bool    `mapstructure:"enable"`
+		Mode             string  `mapstructure:"mode"`
+		WindowBlocks     int64   `mapstructure:"window-blocks"`
+		MinExecutions    uint64  `mapstructure:"min-executions"`
+		MaxRevertRate    float64 `mapstructure:"max-revert-rate"`
+		MaxWastedGas     uint64  `mapstructure:"max-wasted-gas"`
+		QuarantineBlocks int64   `mapstructure:"quarantine-blocks"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
 
 		WASM WASMConfig `mapstructure:"wasm"`
+
+		Compaction CompactionConfig `mapstructure:"compaction"`
+
+		ContractQuarantine ContractQuarantineConfig `mapstructure:"contract-quarantine"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -393,6 +426,7 @@ func initAppConfig() (string, interface{}) {
 	rand.Seed(time.Now().Unix())
 	pruningInterval := primes[rand.Intn(len(primes))]
 	srvCfg.PruningInterval = fmt.Sprintf("%d", pruningInterval)
+	applyNodeModeAppConfig(nodeMode, srvCfg)
 
 	// Metrics
 	srvCfg.Telemetry.Enabled = true
@@ -403,6 +437,11 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		Compaction: CompactionConfig{
+			Enable:      false,
+			MaxEpochTxs: 1000,
+		},
+		ContractQuarantine: ContractQuarantineConfig(antedecorators.DefaultContractQuarantineConfig()),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -411,7 +450,29 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+
+[compaction]
+# Compact the application DB (goleveldb only) in the background after an epoch with little traffic
+enable = {{ .Compaction.Enable }}
+# The most txs an epoch may have for a compaction to start after it
+max-epoch-txs = {{ .Compaction.MaxEpochTxs }}
+
+[contract-quarantine]
+# Quarantine wasm contracts whose recent executions mostly revert, in this node's CheckTx only
+enable = {{ .ContractQuarantine.Enable }}
+# "reject" fails CheckTx for txs executing a quarantined contract, "deprioritize" gives them the lowest priority
+mode = "{{ .ContractQuarantine.Mode }}"
+# The number of recent blocks executions are counted over
+window-blocks = {{ .ContractQuarantine.WindowBlocks }}
+# A contract is quarantined once it was executed at least min-executions times in the window,
+# more than max-revert-rate of those executions failed and the failed executions used more than max-wasted-gas gas
+min-executions = {{ .ContractQuarantine.MinExecutions }}
+max-revert-rate = {{ .ContractQuarantine.MaxRevertRate }}
+max-wasted-gas = {{ .ContractQuarantine.MaxWastedGas }}
+# The number of blocks a contract stays quarantined after it last met the thresholds
+quarantine-blocks = {{ .ContractQuarantine.QuarantineBlocks }}
+` + seidbconfig.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
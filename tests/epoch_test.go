@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/sei-protocol/sei-chain/testutil/processblock"
+	"github.com/sei-protocol/sei-chain/testutil/processblock/msgs"
 	"github.com/sei-protocol/sei-chain/testutil/processblock/verify"
 )
 
@@ -37,3 +38,51 @@ func TestEpoch(t *testing.T) {
 		testCase.run(t, app)
 	}
 }
+
+func TestEpochUsage(t *testing.T) {
+	app := processblock.NewTestApp()
+	p := processblock.DexPreset(app, 3, 1)
+	app.NewMinter(1000000)
+	app.FastEpoch()
+	p.DoRegisterMarkets(app)
+	for i, testCase := range []TestCase{
+		{
+			description: "a send and a send that fails after paying its fee",
+			input: []signing.Tx{
+				app.Sign(p.SignableAccounts[0], 10000, msgs.Send(p.SignableAccounts[0], p.AllAccounts[0], 1000)),
+				app.Sign(p.SignableAccounts[1], 20000, msgs.Send(p.SignableAccounts[1], p.AllAccounts[0], 100000000)),
+			},
+			verifier: []verify.Verifier{
+				verify.EpochUsage,
+			},
+			expectedCodes: []uint32{0, 5},
+		},
+		{
+			description: "a repeated sender and an order that charges dex rent",
+			input: []signing.Tx{
+				app.Sign(p.SignableAccounts[0], 10000, msgs.Send(p.SignableAccounts[0], p.AllAccounts[1], 1000)),
+				app.Sign(p.SignableAccounts[2], 10000, p.AllDexMarkets[0].LongLimitOrder(p.SignableAccounts[2], "10.5", "5")),
+			},
+			verifier: []verify.Verifier{
+				verify.EpochUsage,
+			},
+			expectedCodes: []uint32{0, 0},
+		},
+		{
+			description: "a new epoch that mints",
+			input: []signing.Tx{
+				app.Sign(p.SignableAccounts[0], 10000, msgs.Send(p.SignableAccounts[0], p.AllAccounts[2], 1000)),
+			},
+			verifier: []verify.Verifier{
+				verify.MintRelease,
+				verify.EpochUsage,
+			},
+			expectedCodes: []uint32{0},
+		},
+	} {
+		if i == 2 {
+			time.Sleep(6 * time.Second)
+		}
+		testCase.run(t, app)
+	}
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/sei-protocol/sei-chain/testutil/processblock"
+	"github.com/sei-protocol/sei-chain/testutil/processblock/msgs"
+	"github.com/sei-protocol/sei-chain/testutil/processblock/verify"
+)
+
+func TestSequenceHistory(t *testing.T) {
+	app := processblock.NewTestApp()
+	p := processblock.CommonPreset(app)
+	sender := app.NewSignableAccount("sender")
+	app.FundAccount(sender, 100000)
+	for _, testCase := range []TestCase{
+		{
+			description: "sends, one of which fails after consuming its sequence",
+			input: []signing.Tx{
+				app.Sign(p.Admin, 10000, msgs.Send(p.Admin, p.AllAccounts[0], 1000)),
+				app.Sign(p.Admin, 10000, msgs.Send(p.Admin, p.AllAccounts[1], 1000)),
+				app.Sign(sender, 10000, msgs.Send(sender, p.AllAccounts[0], 1000000)),
+			},
+			verifier: []verify.Verifier{
+				verify.SequenceHistory,
+			},
+			expectedCodes: []uint32{0, 0, 5},
+		},
+		{
+			description: "a tx with a wrong sequence",
+			input: func() []signing.Tx {
+				// the first signed tx is never submitted, so the second's sequence is ahead
+				_ = app.Sign(p.Admin, 10000, msgs.Send(p.Admin, p.AllAccounts[0], 1000))
+				return []signing.Tx{
+					app.Sign(p.Admin, 10000, msgs.Send(p.Admin, p.AllAccounts[0], 1000)),
+				}
+			}(),
+			verifier: []verify.Verifier{
+				verify.SequenceHistory,
+			},
+			expectedCodes: []uint32{32},
+		},
+	} {
+		testCase.run(t, app)
+	}
+}
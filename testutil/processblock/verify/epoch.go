@@ -3,8 +3,10 @@ package verify
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/sei-protocol/sei-chain/testutil/processblock"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,3 +21,67 @@ func Epoch(t *testing.T, app *processblock.App, f BlockRunnable, _ []signing.Tx)
 		return res
 	}
 }
+
+// Check that the block's txs, new fee payers and fees are added to the current
+// epoch's usage. Collected fees are the tx fees plus the dex rent charged in
+// the block, but not the coins minted when an epoch ends. Burned fees are the
+// blob fees burned in the block.
+// Only works if no transaction is rejected by the ante handler.
+func EpochUsage(t *testing.T, app *processblock.App, f BlockRunnable, txs []signing.Tx) BlockRunnable {
+	return func() []uint32 {
+		oldEpoch := app.EpochKeeper.GetEpoch(app.Ctx())
+		oldUsage := app.EpochKeeper.GetCurrentEpochUsage(app.Ctx())
+		seenSenders := map[string]bool{}
+		for _, sender := range app.EpochKeeper.GetCurrentEpochSenders(app.Ctx()) {
+			seenSenders[sender.String()] = true
+		}
+		oldRent := totalDexRent(app)
+
+		res := f()
+
+		if newEpoch := app.EpochKeeper.GetEpoch(app.Ctx()); newEpoch.CurrentEpoch != oldEpoch.CurrentEpoch {
+			// the epoch ended in BeginBlock, before any of the block's txs ran
+			report, found := app.EpochKeeper.GetEpochUsage(app.Ctx(), oldEpoch.CurrentEpoch)
+			require.True(t, found)
+			require.Equal(t, oldUsage.TxCount, report.TxCount)
+			require.Equal(t, oldUsage.FeesCollected, report.FeesCollected)
+			require.Equal(t, oldUsage.UniqueSenders, report.UniqueSenders)
+			require.Equal(t, oldUsage.FeesBurned, report.FeesBurned)
+			oldUsage = epochtypes.EpochUsage{FeesCollected: sdk.NewCoins(), FeesBurned: sdk.NewCoins()}
+			seenSenders = map[string]bool{}
+		}
+
+		expectedFees := oldUsage.FeesCollected
+		expectedSenders := oldUsage.UniqueSenders
+		for _, tx := range txs {
+			expectedFees = expectedFees.Add(tx.GetFee()...)
+			if !seenSenders[tx.FeePayer().String()] {
+				seenSenders[tx.FeePayer().String()] = true
+				expectedSenders++
+			}
+		}
+		if rent := int64(oldRent) - int64(totalDexRent(app)); rent > 0 {
+			expectedFees = expectedFees.Add(sdk.NewInt64Coin("usei", rent))
+		}
+
+		expectedBurned := oldUsage.FeesBurned.Add(app.BlobKeeper.GetBlockFeesBurned(app.Ctx())...)
+
+		newUsage := app.EpochKeeper.GetCurrentEpochUsage(app.Ctx())
+		require.Equal(t, oldUsage.TxCount+uint64(len(txs)), newUsage.TxCount)
+		require.Equal(t, expectedFees, newUsage.FeesCollected)
+		require.Equal(t, expectedBurned, newUsage.FeesBurned)
+		require.Equal(t, expectedSenders, newUsage.UniqueSenders)
+		if len(txs) > 0 {
+			require.Greater(t, newUsage.GasUsed, oldUsage.GasUsed)
+		}
+		return res
+	}
+}
+
+func totalDexRent(app *processblock.App) uint64 {
+	total := uint64(0)
+	for _, contract := range app.DexKeeper.GetAllContractInfo(app.Ctx()) {
+		total += contract.RentBalance
+	}
+	return total
+}
@@ -0,0 +1,40 @@
+package verify
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/sei-protocol/sei-chain/testutil/processblock"
+	seqhistorytypes "github.com/sei-protocol/sei-chain/x/seqhistory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Check that every sequence the block's txs consumed is recorded with the
+// consuming tx's hash and the block's height, including txs whose msgs
+// failed. Txs rejected for a wrong sequence must not be recorded.
+func SequenceHistory(t *testing.T, app *processblock.App, f BlockRunnable, txs []signing.Tx) BlockRunnable {
+	return func() []uint32 {
+		res := f()
+
+		height := app.Ctx().BlockHeight()
+
+		for i, tx := range txs {
+			bz, err := processblock.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			txHash := seqhistorytypes.TxHash(bz)
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			for j, signer := range tx.GetSigners() {
+				record, found := app.SeqHistoryKeeper.GetSequenceRecord(app.Ctx(), signer, sigs[j].Sequence)
+				if res[i] == sdkerrors.ErrWrongSequence.ABCICode() {
+					require.False(t, found && record.TxHash == txHash)
+					continue
+				}
+				require.True(t, found)
+				require.Equal(t, seqhistorytypes.NewSequenceRecord(signer.String(), sigs[j].Sequence, txHash, height), record)
+			}
+		}
+		return res
+	}
+}
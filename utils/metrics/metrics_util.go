@@ -222,3 +222,27 @@ func IncrementOptimisticProcessingCounter(enabled bool) {
 		[]metrics.Label{telemetry.NewLabel("enabled", strconv.FormatBool(enabled))},
 	)
 }
+
+// Measures the CheckTx actions the contract quarantine takes, by action
+// (quarantined, rejected or deprioritized)
+// Metric Name:
+//
+//	sei_contract_quarantine_count
+func IncrContractQuarantineCounter(action string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "contract", "quarantine", "count"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("action", action)},
+	)
+}
+
+// Gauge metric with the number of contracts currently quarantined
+// Metric Name:
+//
+//	sei_contract_quarantine_size
+func SetQuarantinedContracts(count int) {
+	telemetry.SetGauge(
+		float32(count),
+		"sei", "contract", "quarantine", "size",
+	)
+}
@@ -0,0 +1,40 @@
+package blob
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob/keeper"
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+// BeginBlocker resets the fees burned counter the epoch usage reads at the end
+// of the block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ResetBlockFeesBurned(ctx)
+}
+
+// EndBlocker prunes up to max_prunes_per_block blobs that fell out of the
+// retention window and adjusts the fee per byte based on the blob bytes
+// included in this block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if pruneHeight := ctx.BlockHeight() - int64(params.RetentionBlocks); pruneHeight > 0 {
+		if count := k.PruneBlobsBefore(ctx, pruneHeight, params.MaxPrunesPerBlock); count > 0 {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePruneBlobs,
+					sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprint(count)),
+					sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(pruneHeight)),
+				),
+			)
+		}
+	}
+
+	k.UpdateFeePerByte(ctx)
+}
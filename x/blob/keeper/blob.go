@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+// SubmitBlob charges the sender for data at the current fee per byte, burns
+// the fee and stores the blob under its commitment at the current height.
+func (k Keeper) SubmitBlob(ctx sdk.Context, sender sdk.AccAddress, data []byte, maxFeePerByte sdk.Dec) (string, sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	size := uint64(len(data))
+	if size == 0 {
+		return "", sdk.Coin{}, types.ErrEmptyBlob
+	}
+	if size > params.MaxBlobSize {
+		return "", sdk.Coin{}, sdkerrors.Wrapf(types.ErrBlobTooLarge, "%d > %d", size, params.MaxBlobSize)
+	}
+	blockBytes := k.GetBlockBytes(ctx)
+	if blockBytes+size > params.MaxBytesPerBlock {
+		return "", sdk.Coin{}, sdkerrors.Wrapf(types.ErrBlockBytesFull, "%d bytes already used out of %d", blockBytes, params.MaxBytesPerBlock)
+	}
+
+	feePerByte := k.GetFeePerByte(ctx)
+	if feePerByte.GT(maxFeePerByte) {
+		return "", sdk.Coin{}, sdkerrors.Wrapf(types.ErrFeeAboveMax, "%s > %s", feePerByte, maxFeePerByte)
+	}
+
+	commitment := types.Commitment(data)
+	if _, found := k.GetBlob(ctx, commitment); found {
+		return "", sdk.Coin{}, sdkerrors.Wrapf(types.ErrBlobExists, "%s", commitment)
+	}
+
+	fee := sdk.NewCoin(params.FeeDenom, feePerByte.MulInt64(int64(size)).Ceil().TruncateInt())
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+			return "", sdk.Coin{}, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+			return "", sdk.Coin{}, err
+		}
+		k.AddBlockFeesBurned(ctx, fee)
+	}
+
+	k.SetBlob(ctx, types.Blob{
+		Commitment: commitment,
+		Submitter:  sender.String(),
+		Height:     ctx.BlockHeight(),
+		Data:       data,
+	})
+	k.SetBlockBytes(ctx, blockBytes+size)
+
+	return commitment, fee, nil
+}
+
+// SetBlob stores a blob and indexes it by inclusion height
+func (k Keeper) SetBlob(ctx sdk.Context, blob types.Blob) {
+	commitment, err := types.DecodeCommitment(blob.Commitment)
+	if err != nil {
+		panic(err)
+	}
+	k.blobStore(ctx).Set(commitment, k.cdc.MustMarshal(&blob))
+	k.heightIndexStore(ctx).Set(types.HeightIndexKeyFor(blob.Height, commitment), []byte{})
+}
+
+// GetBlob returns the blob stored under commitment, if any
+func (k Keeper) GetBlob(ctx sdk.Context, commitment string) (types.Blob, bool) {
+	bz, err := types.DecodeCommitment(commitment)
+	if err != nil {
+		return types.Blob{}, false
+	}
+	value := k.blobStore(ctx).Get(bz)
+	if value == nil {
+		return types.Blob{}, false
+	}
+	blob := types.Blob{}
+	k.cdc.MustUnmarshal(value, &blob)
+	return blob, true
+}
+
+// GetAllBlobs returns every stored blob in commitment order
+func (k Keeper) GetAllBlobs(ctx sdk.Context) []types.Blob {
+	iterator := k.blobStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	blobs := []types.Blob{}
+	for ; iterator.Valid(); iterator.Next() {
+		blob := types.Blob{}
+		k.cdc.MustUnmarshal(iterator.Value(), &blob)
+		blobs = append(blobs, blob)
+	}
+	return blobs
+}
+
+// PruneBlobsBefore deletes up to limit of the oldest blobs included at or
+// before height and returns how many were removed. Blobs left over because of
+// the limit are the oldest ones on the next call.
+func (k Keeper) PruneBlobsBefore(ctx sdk.Context, height int64, limit uint64) int {
+	indexStore := k.heightIndexStore(ctx)
+	iterator := indexStore.Iterator(nil, types.HeightIndexEndKey(height))
+	keys := [][]byte{}
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	blobStore := k.blobStore(ctx)
+	for _, key := range keys {
+		// index keys are the 8 byte big endian height followed by the commitment
+		blobStore.Delete(key[8:])
+		indexStore.Delete(key)
+	}
+
+	if len(keys) > 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("pruned %d blobs included at or before height %d", len(keys), height))
+	}
+	return len(keys)
+}
+
+func (k Keeper) blobStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobKeyPrefix())
+}
+
+func (k Keeper) heightIndexStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.HeightIndexPrefix())
+}
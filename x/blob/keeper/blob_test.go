@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob"
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+func (suite *KeeperTestSuite) TestSubmitBlob() {
+	k := suite.App.BlobKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	params := k.GetParams(suite.Ctx)
+
+	sender, unfunded := suite.TestAccs[0], suite.TestAccs[1]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1000)))
+	supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, params.FeeDenom)
+
+	data := bytes.Repeat([]byte{1}, 150)
+	commitment, fee, err := k.SubmitBlob(suite.Ctx, sender, data, sdk.OneDec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Commitment(data), commitment)
+	// 150 bytes at 0.01 per byte rounds up to 2
+	suite.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 2), fee)
+	suite.Require().Equal(int64(998), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, params.FeeDenom).Amount.Int64())
+	suite.Require().Equal(supplyBefore.Amount.SubRaw(2), suite.App.BankKeeper.GetSupply(suite.Ctx, params.FeeDenom).Amount)
+	suite.Require().Equal(uint64(150), k.GetBlockBytes(suite.Ctx))
+	suite.Require().Equal(sdk.NewCoins(fee), k.GetBlockFeesBurned(suite.Ctx))
+
+	stored, found := k.GetBlob(suite.Ctx, commitment)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Blob{Commitment: commitment, Submitter: sender.String(), Height: 10, Data: data}, stored)
+
+	// same data can't be submitted twice
+	_, _, err = k.SubmitBlob(suite.Ctx, sender, data, sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrBlobExists)
+
+	// fee above the sender's max
+	_, _, err = k.SubmitBlob(suite.Ctx, sender, []byte{2}, sdk.NewDecWithPrec(1, 3))
+	suite.Require().ErrorIs(err, types.ErrFeeAboveMax)
+
+	// blob above max size
+	_, _, err = k.SubmitBlob(suite.Ctx, sender, make([]byte, params.MaxBlobSize+1), sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrBlobTooLarge)
+
+	// block bytes exhausted
+	k.SetBlockBytes(suite.Ctx, params.MaxBytesPerBlock)
+	_, _, err = k.SubmitBlob(suite.Ctx, sender, []byte{3}, sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrBlockBytesFull)
+
+	// insufficient funds
+	k.SetBlockBytes(suite.Ctx, 0)
+	_, _, err = k.SubmitBlob(suite.Ctx, unfunded, []byte{4}, sdk.OneDec())
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestPruneBlobsBefore() {
+	k := suite.App.BlobKeeper
+	submitter := suite.TestAccs[0].String()
+
+	for height := int64(1); height <= 5; height++ {
+		data := []byte{byte(height)}
+		k.SetBlob(suite.Ctx, types.Blob{
+			Commitment: types.Commitment(data),
+			Submitter:  submitter,
+			Height:     height,
+			Data:       data,
+		})
+	}
+
+	suite.Require().Equal(3, k.PruneBlobsBefore(suite.Ctx, 3, 10))
+	suite.Require().Equal(0, k.PruneBlobsBefore(suite.Ctx, 3, 10))
+	blobs := k.GetAllBlobs(suite.Ctx)
+	suite.Require().Len(blobs, 2)
+	for _, stored := range blobs {
+		suite.Require().Greater(stored.Height, int64(3))
+	}
+	_, found := k.GetBlob(suite.Ctx, types.Commitment([]byte{1}))
+	suite.Require().False(found)
+	_, found = k.GetBlob(suite.Ctx, types.Commitment([]byte{4}))
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneBlobsBeforeLimit() {
+	k := suite.App.BlobKeeper
+	submitter := suite.TestAccs[0].String()
+
+	// two blobs at each of heights 1 to 3
+	for i := 0; i < 6; i++ {
+		data := []byte{byte(i)}
+		k.SetBlob(suite.Ctx, types.Blob{
+			Commitment: types.Commitment(data),
+			Submitter:  submitter,
+			Height:     int64(i/2 + 1),
+			Data:       data,
+		})
+	}
+	heights := func() []int64 {
+		heights := []int64{}
+		for _, stored := range k.GetAllBlobs(suite.Ctx) {
+			heights = append(heights, stored.Height)
+		}
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+		return heights
+	}
+
+	// the oldest blobs go first
+	suite.Require().Equal(3, k.PruneBlobsBefore(suite.Ctx, 2, 3))
+	suite.Require().Equal([]int64{2, 3, 3}, heights())
+
+	// the next call resumes with what the limit left over
+	suite.Require().Equal(1, k.PruneBlobsBefore(suite.Ctx, 2, 3))
+	suite.Require().Equal([]int64{3, 3}, heights())
+	suite.Require().Equal(0, k.PruneBlobsBefore(suite.Ctx, 2, 3))
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerCapsPrunes() {
+	k := suite.App.BlobKeeper
+	params := k.GetParams(suite.Ctx)
+	params.RetentionBlocks = 1
+	params.MaxPrunesPerBlock = 2
+	k.SetParams(suite.Ctx, params)
+
+	submitter := suite.TestAccs[0].String()
+	for i := 0; i < 5; i++ {
+		data := []byte{byte(i)}
+		k.SetBlob(suite.Ctx, types.Blob{Commitment: types.Commitment(data), Submitter: submitter, Height: 1, Data: data})
+	}
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	for _, remaining := range []int{3, 1, 0} {
+		blob.EndBlocker(suite.Ctx, k)
+		suite.Require().Len(k.GetAllBlobs(suite.Ctx), remaining)
+	}
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+// GetFeePerByte returns the current blob fee per byte, falling back to the
+// min fee per byte param if the fee market has not been initialized
+func (k Keeper) GetFeePerByte(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.FeePerByteKey))
+	if bz == nil {
+		return k.GetParams(ctx).MinFeePerByte
+	}
+	fee := sdk.Dec{}
+	if err := fee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return fee
+}
+
+func (k Keeper) SetFeePerByte(ctx sdk.Context, fee sdk.Dec) {
+	bz, err := fee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.FeePerByteKey), bz)
+}
+
+// GetBlockBytes returns the blob bytes included so far in the current block
+func (k Keeper) GetBlockBytes(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.BlockBytesKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetBlockBytes(ctx sdk.Context, size uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, size)
+	ctx.KVStore(k.storeKey).Set([]byte(types.BlockBytesKey), bz)
+}
+
+// GetBlockFeesBurned returns the blob fees burned so far in the current block
+func (k Keeper) GetBlockFeesBurned(ctx sdk.Context) sdk.Coins {
+	iterator := k.blockBurnedStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		amount := sdk.Int{}
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned
+}
+
+// AddBlockFeesBurned adds fee to the blob fees burned in the current block
+func (k Keeper) AddBlockFeesBurned(ctx sdk.Context, fee sdk.Coin) {
+	store := k.blockBurnedStore(ctx)
+	amount := fee.Amount
+	if bz := store.Get([]byte(fee.Denom)); bz != nil {
+		previous := sdk.Int{}
+		if err := previous.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		amount = amount.Add(previous)
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// ResetBlockFeesBurned clears the blob fees burned counter for a new block
+func (k Keeper) ResetBlockFeesBurned(ctx sdk.Context) {
+	store := k.blockBurnedStore(ctx)
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) blockBurnedStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockBurnedPrefix())
+}
+
+// UpdateFeePerByte moves the fee per byte towards the target block usage in
+// the same way EIP-1559 adjusts the base fee: the fee changes by at most
+// 1/FeeChangeDenominator per block, proportionally to how far usage was from
+// target, and never drops below MinFeePerByte. The block usage counter is
+// reset for the next block.
+func (k Keeper) UpdateFeePerByte(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	fee := k.GetFeePerByte(ctx)
+	used := k.GetBlockBytes(ctx)
+	target := params.TargetBytesPerBlock
+
+	if used != target {
+		delta := fee.MulInt64(int64(absDiff(used, target))).QuoInt64(int64(target)).QuoInt64(int64(params.FeeChangeDenominator))
+		if used > target {
+			fee = fee.Add(delta)
+		} else {
+			fee = fee.Sub(delta)
+		}
+	}
+	if fee.LT(params.MinFeePerByte) {
+		fee = params.MinFeePerByte
+	}
+
+	k.SetFeePerByte(ctx, fee)
+	k.SetBlockBytes(ctx, 0)
+	return fee
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
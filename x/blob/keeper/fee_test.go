@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateFeePerByte() {
+	k := suite.App.BlobKeeper
+	params := k.GetParams(suite.Ctx)
+
+	start := sdk.NewDec(8)
+	k.SetFeePerByte(suite.Ctx, start)
+
+	// full blocks raise the fee by 1/FeeChangeDenominator
+	k.SetBlockBytes(suite.Ctx, params.MaxBytesPerBlock)
+	fee := k.UpdateFeePerByte(suite.Ctx)
+	suite.Require().Equal(sdk.NewDec(9), fee)
+	suite.Require().Equal(uint64(0), k.GetBlockBytes(suite.Ctx))
+
+	// exactly on target leaves the fee unchanged
+	k.SetBlockBytes(suite.Ctx, params.TargetBytesPerBlock)
+	suite.Require().Equal(sdk.NewDec(9), k.UpdateFeePerByte(suite.Ctx))
+
+	// empty blocks lower the fee by 1/FeeChangeDenominator
+	k.SetFeePerByte(suite.Ctx, start)
+	suite.Require().Equal(sdk.NewDec(7), k.UpdateFeePerByte(suite.Ctx))
+
+	// the fee never goes below the minimum
+	k.SetFeePerByte(suite.Ctx, params.MinFeePerByte)
+	suite.Require().Equal(params.MinFeePerByte, k.UpdateFeePerByte(suite.Ctx))
+	suite.Require().Equal(params.MinFeePerByte, k.GetFeePerByte(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestBlockFeesBurned() {
+	k := suite.App.BlobKeeper
+	suite.Require().True(k.GetBlockFeesBurned(suite.Ctx).IsZero())
+
+	k.AddBlockFeesBurned(suite.Ctx, sdk.NewInt64Coin("usei", 2))
+	k.AddBlockFeesBurned(suite.Ctx, sdk.NewInt64Coin("usei", 3))
+	k.AddBlockFeesBurned(suite.Ctx, sdk.NewInt64Coin("uatom", 1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("usei", 5)), k.GetBlockFeesBurned(suite.Ctx))
+
+	k.ResetBlockFeesBurned(suite.Ctx)
+	suite.Require().True(k.GetBlockFeesBurned(suite.Ctx).IsZero())
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+// InitGenesis initializes the blob module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.CreateModuleAccount(ctx)
+	k.SetParams(ctx, genState.Params)
+	k.SetFeePerByte(ctx, genState.FeePerByte)
+	for _, blob := range genState.Blobs {
+		k.SetBlob(ctx, blob)
+	}
+}
+
+// ExportGenesis returns the blob module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		FeePerByte: k.GetFeePerByte(ctx),
+		Blobs:      k.GetAllBlobs(ctx),
+	}
+}
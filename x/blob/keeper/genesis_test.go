@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	data := []byte("blob")
+	genState := types.DefaultGenesis()
+	genState.FeePerByte = sdk.NewDecWithPrec(5, 2)
+	genState.Blobs = []types.Blob{{
+		Commitment: types.Commitment(data),
+		Submitter:  suite.TestAccs[0].String(),
+		Height:     7,
+		Data:       data,
+	}}
+	suite.Require().NoError(genState.Validate())
+
+	suite.App.BlobKeeper.InitGenesis(suite.Ctx, *genState)
+	suite.Require().Equal(genState, suite.App.BlobKeeper.ExportGenesis(suite.Ctx))
+}
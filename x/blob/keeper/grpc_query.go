@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: k.GetParams(sdkCtx)}, nil
+}
+
+func (k Keeper) Blob(ctx context.Context, req *types.QueryBlobRequest) (*types.QueryBlobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := types.DecodeCommitment(req.Commitment); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blob, found := k.GetBlob(sdkCtx, req.Commitment)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrBlobNotFound.Error())
+	}
+	return &types.QueryBlobResponse{Blob: blob}, nil
+}
+
+func (k Keeper) FeePerByte(ctx context.Context, _ *types.QueryFeePerByteRequest) (*types.QueryFeePerByteResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryFeePerByteResponse{
+		FeePerByte:     k.GetFeePerByte(sdkCtx),
+		BlockBytesUsed: k.GetBlockBytes(sdkCtx),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) SubmitBlob(goCtx context.Context, msg *types.MsgSubmitBlob) (*types.MsgSubmitBlobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, fee, err := server.Keeper.SubmitBlob(ctx, sender, msg.Data, msg.MaxFeePerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitBlob,
+			sdk.NewAttribute(types.AttributeKeyCommitment, commitment),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeySize, fmt.Sprint(len(msg.Data))),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return &types.MsgSubmitBlobResponse{Commitment: commitment, Fee: fee}, nil
+}
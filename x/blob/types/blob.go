@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Commitment returns the hex encoded sha256 commitment of data
+func Commitment(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// DecodeCommitment parses a hex encoded commitment
+func DecodeCommitment(commitment string) ([]byte, error) {
+	bz, err := hex.DecodeString(commitment)
+	if err != nil || len(bz) != CommitmentLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidCommitment, "%s", commitment)
+	}
+	return bz, nil
+}
+
+// Validate checks that the blob commitment matches its data
+func (b Blob) Validate() error {
+	if len(b.Data) == 0 {
+		return ErrEmptyBlob
+	}
+	if Commitment(b.Data) != b.Commitment {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "commitment %s does not match data", b.Commitment)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Submitter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address (%s)", err)
+	}
+	return nil
+}
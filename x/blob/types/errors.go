@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/blob module sentinel errors
+var (
+	ErrBlobTooLarge      = sdkerrors.Register(ModuleName, 2, "blob exceeds max blob size")
+	ErrEmptyBlob         = sdkerrors.Register(ModuleName, 3, "blob data is empty")
+	ErrBlockBytesFull    = sdkerrors.Register(ModuleName, 4, "block blob capacity exhausted")
+	ErrFeeAboveMax       = sdkerrors.Register(ModuleName, 5, "current fee per byte exceeds max fee per byte")
+	ErrBlobExists        = sdkerrors.Register(ModuleName, 6, "blob with the same commitment is already stored")
+	ErrBlobNotFound      = sdkerrors.Register(ModuleName, 7, "blob not found")
+	ErrInvalidCommitment = sdkerrors.Register(ModuleName, 8, "invalid blob commitment")
+)
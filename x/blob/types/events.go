@@ -0,0 +1,14 @@
+package types
+
+// blob module event types
+const (
+	EventTypeSubmitBlob = "submit_blob"
+	EventTypePruneBlobs = "prune_blobs"
+
+	AttributeKeyCommitment = "commitment"
+	AttributeKeySubmitter  = "submitter"
+	AttributeKeySize       = "size"
+	AttributeKeyFee        = "fee"
+	AttributeKeyCount      = "count"
+	AttributeKeyHeight     = "height"
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the expected interface needed to charge and burn blob fees.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
+}
+
+// AccountKeeper defines the expected interface needed to create the module account.
+type AccountKeeper interface {
+	SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI)
+}
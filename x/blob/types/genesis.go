@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default blob genesis state
+func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+	return &GenesisState{
+		Params:     params,
+		FeePerByte: params.MinFeePerByte,
+		Blobs:      []Blob{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.FeePerByte.IsNil() || gs.FeePerByte.LT(gs.Params.MinFeePerByte) {
+		return fmt.Errorf("fee per byte %s must be at least min fee per byte %s", gs.FeePerByte, gs.Params.MinFeePerByte)
+	}
+
+	seen := map[string]bool{}
+	for _, blob := range gs.Blobs {
+		if err := blob.Validate(); err != nil {
+			return err
+		}
+		if seen[blob.Commitment] {
+			return fmt.Errorf("duplicate blob commitment %s", blob.Commitment)
+		}
+		seen[blob.Commitment] = true
+	}
+
+	return nil
+}
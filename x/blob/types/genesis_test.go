@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/blob/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	data := []byte("blob")
+	blob := types.Blob{
+		Commitment: types.Commitment(data),
+		Submitter:  sdk.AccAddress([]byte("blob_submitter______")).String(),
+		Height:     1,
+		Data:       data,
+	}
+
+	unboundedPrunes := types.DefaultParams()
+	unboundedPrunes.MaxPrunesPerBlock = 0
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "invalid genesis state",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "valid blob",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePerByte: types.DefaultMinFeePerByte,
+				Blobs:      []types.Blob{blob},
+			},
+			valid: true,
+		},
+		{
+			desc: "fee below min",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePerByte: sdk.ZeroDec(),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max prunes per block",
+			genState: &types.GenesisState{
+				Params:     unboundedPrunes,
+				FeePerByte: types.DefaultMinFeePerByte,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate blob",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePerByte: types.DefaultMinFeePerByte,
+				Blobs:      []types.Blob{blob, blob},
+			},
+			valid: false,
+		},
+		{
+			desc: "commitment mismatch",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePerByte: types.DefaultMinFeePerByte,
+				Blobs:      []types.Blob{{Commitment: blob.Commitment, Submitter: blob.Submitter, Height: 1, Data: []byte("other")}},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "blob"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the blob module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+const (
+	BlobKey          = "blob-"
+	HeightIndexKey   = "height-index-"
+	FeePerByteKey    = "fee-per-byte"
+	BlockBytesKey    = "block-bytes"
+	BlockBurnedKey   = "block-burned-"
+	CommitmentLength = 32
+)
+
+// BlobKeyPrefix returns the store prefix under which blobs are keyed by commitment
+func BlobKeyPrefix() []byte {
+	return []byte(BlobKey)
+}
+
+// HeightIndexPrefix returns the store prefix of the (height, commitment) index
+// used to prune blobs once they fall out of the retention window
+func HeightIndexPrefix() []byte {
+	return []byte(HeightIndexKey)
+}
+
+// BlockBurnedPrefix returns the store prefix under which the fees burned in
+// the current block are keyed by denom
+func BlockBurnedPrefix() []byte {
+	return []byte(BlockBurnedKey)
+}
+
+// HeightIndexKeyFor returns the index key of a blob included at height. Heights
+// are big endian encoded so that iteration follows inclusion order.
+func HeightIndexKeyFor(height int64, commitment []byte) []byte {
+	key := make([]byte, 8, 8+len(commitment))
+	binary.BigEndian.PutUint64(key, uint64(height))
+	return append(key, commitment...)
+}
+
+// HeightIndexEndKey returns the first index key past every blob included at or
+// before height
+func HeightIndexEndKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height+1))
+	return key
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitBlob = "submit_blob"
+
+var _ sdk.Msg = &MsgSubmitBlob{}
+
+// NewMsgSubmitBlob creates a msg to store a data blob
+func NewMsgSubmitBlob(sender string, data []byte, maxFeePerByte sdk.Dec) *MsgSubmitBlob {
+	return &MsgSubmitBlob{
+		Sender:        sender,
+		Data:          data,
+		MaxFeePerByte: maxFeePerByte,
+	}
+}
+
+func (m MsgSubmitBlob) Route() string { return RouterKey }
+func (m MsgSubmitBlob) Type() string  { return TypeMsgSubmitBlob }
+func (m MsgSubmitBlob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(m.Data) == 0 {
+		return ErrEmptyBlob
+	}
+
+	if m.MaxFeePerByte.IsNil() || !m.MaxFeePerByte.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max fee per byte must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgSubmitBlob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSubmitBlob) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	KeyMaxBlobSize          = []byte("MaxBlobSize")
+	KeyMaxBytesPerBlock     = []byte("MaxBytesPerBlock")
+	KeyTargetBytesPerBlock  = []byte("TargetBytesPerBlock")
+	KeyRetentionBlocks      = []byte("RetentionBlocks")
+	KeyMinFeePerByte        = []byte("MinFeePerByte")
+	KeyFeeChangeDenominator = []byte("FeeChangeDenominator")
+	KeyFeeDenom             = []byte("FeeDenom")
+	KeyMaxPrunesPerBlock    = []byte("MaxPrunesPerBlock")
+)
+
+const (
+	DefaultMaxBlobSize          = 128 * 1024        // 128KiB
+	DefaultMaxBytesPerBlock     = 2 * 1024 * 1024   // 2MiB
+	DefaultTargetBytesPerBlock  = 1024 * 1024       // 1MiB
+	DefaultRetentionBlocks      = 7 * 24 * 3600 * 2 // roughly one week of blocks
+	DefaultFeeChangeDenominator = 8
+	DefaultFeeDenom             = "usei"
+	DefaultMaxPrunesPerBlock    = 1000
+)
+
+var DefaultMinFeePerByte = sdk.NewDecWithPrec(1, 2) // 0.01
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the blob module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		MaxBlobSize:          DefaultMaxBlobSize,
+		MaxBytesPerBlock:     DefaultMaxBytesPerBlock,
+		TargetBytesPerBlock:  DefaultTargetBytesPerBlock,
+		RetentionBlocks:      DefaultRetentionBlocks,
+		MinFeePerByte:        DefaultMinFeePerByte,
+		FeeChangeDenominator: DefaultFeeChangeDenominator,
+		FeeDenom:             DefaultFeeDenom,
+		MaxPrunesPerBlock:    DefaultMaxPrunesPerBlock,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxBlobSize, &p.MaxBlobSize, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxBytesPerBlock, &p.MaxBytesPerBlock, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyTargetBytesPerBlock, &p.TargetBytesPerBlock, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyRetentionBlocks, &p.RetentionBlocks, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMinFeePerByte, &p.MinFeePerByte, validateMinFeePerByte),
+		paramtypes.NewParamSetPair(KeyFeeChangeDenominator, &p.FeeChangeDenominator, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMaxPrunesPerBlock, &p.MaxPrunesPerBlock, validatePositiveUint64),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	for _, v := range []uint64{p.MaxBlobSize, p.MaxBytesPerBlock, p.TargetBytesPerBlock, p.RetentionBlocks, p.FeeChangeDenominator, p.MaxPrunesPerBlock} {
+		if err := validatePositiveUint64(v); err != nil {
+			return err
+		}
+	}
+	if p.MaxBlobSize > p.MaxBytesPerBlock {
+		return fmt.Errorf("max blob size %d must not exceed max bytes per block %d", p.MaxBlobSize, p.MaxBytesPerBlock)
+	}
+	if p.TargetBytesPerBlock > p.MaxBytesPerBlock {
+		return fmt.Errorf("target bytes per block %d must not exceed max bytes per block %d", p.TargetBytesPerBlock, p.MaxBytesPerBlock)
+	}
+	if err := validateMinFeePerByte(p.MinFeePerByte); err != nil {
+		return err
+	}
+	return validateFeeDenom(p.FeeDenom)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("parameter must be a positive integer: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinFeePerByte(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("min fee per byte must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
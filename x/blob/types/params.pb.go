@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blob/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the blob module.
+type Params struct {
+	// max_blob_size is the largest blob, in bytes, a single message may submit.
+	MaxBlobSize uint64 `protobuf:"varint,1,opt,name=max_blob_size,json=maxBlobSize,proto3" json:"max_blob_size" yaml:"max_blob_size"`
+	// max_bytes_per_block caps the total blob bytes accepted in one block.
+	MaxBytesPerBlock uint64 `protobuf:"varint,2,opt,name=max_bytes_per_block,json=maxBytesPerBlock,proto3" json:"max_bytes_per_block" yaml:"max_bytes_per_block"`
+	// target_bytes_per_block is the usage at which the fee per byte stays flat.
+	TargetBytesPerBlock uint64 `protobuf:"varint,3,opt,name=target_bytes_per_block,json=targetBytesPerBlock,proto3" json:"target_bytes_per_block" yaml:"target_bytes_per_block"`
+	// retention_blocks is the number of blocks a blob is kept before pruning.
+	RetentionBlocks uint64 `protobuf:"varint,4,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks" yaml:"retention_blocks"`
+	// min_fee_per_byte is the floor of the blob fee market.
+	MinFeePerByte github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_fee_per_byte,json=minFeePerByte,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_per_byte" yaml:"min_fee_per_byte"`
+	// fee_change_denominator bounds how fast the fee per byte moves per block.
+	FeeChangeDenominator uint64 `protobuf:"varint,6,opt,name=fee_change_denominator,json=feeChangeDenominator,proto3" json:"fee_change_denominator" yaml:"fee_change_denominator"`
+	// fee_denom is the denom blob fees are charged and burned in.
+	FeeDenom string `protobuf:"bytes,7,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom" yaml:"fee_denom"`
+	// max_prunes_per_block caps how many expired blobs one EndBlock deletes.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,8,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block" yaml:"max_prunes_per_block"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eb7255e25c6327c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxBlobSize() uint64 {
+	if m != nil {
+		return m.MaxBlobSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBytesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxBytesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTargetBytesPerBlock() uint64 {
+	if m != nil {
+		return m.TargetBytesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.FeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.blob.Params")
+}
+
+func init() { proto.RegisterFile("blob/params.proto", fileDescriptor_6eb7255e25c6327c) }
+
+var fileDescriptor_6eb7255e25c6327c = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x18, 0x65, 0x33, 0x9a, 0xe8, 0xb2, 0x6a, 0x84, 0x21, 0xe2, 0xe1, 0x03, 0x1a,
+	0x87, 0x25, 0x07, 0x84, 0x90, 0x86, 0xc4, 0xa1, 0x4c, 0x88, 0x0b, 0x52, 0x15, 0x6e, 0x93, 0x50,
+	0xe5, 0xa6, 0x5f, 0x5b, 0x6b, 0x75, 0x1c, 0x62, 0x4f, 0x6a, 0xf7, 0x00, 0x9c, 0x39, 0x72, 0xdc,
+	0x7b, 0xf0, 0x02, 0x3b, 0xee, 0x88, 0x38, 0x58, 0xa8, 0xbd, 0xa0, 0x1c, 0xf3, 0x04, 0xc8, 0xce,
+	0x46, 0xd6, 0x2e, 0x9c, 0xea, 0xfe, 0x7f, 0xff, 0x7c, 0xff, 0xcf, 0xf6, 0x67, 0xb4, 0xd5, 0x9f,
+	0x88, 0x7e, 0x98, 0xd2, 0x8c, 0x72, 0x19, 0xa4, 0x99, 0x50, 0xc2, 0x7d, 0x2c, 0x81, 0xd9, 0x55,
+	0x2c, 0x26, 0x81, 0x04, 0x16, 0x8f, 0x29, 0x4b, 0x02, 0xe3, 0xdb, 0x6d, 0x8f, 0xc4, 0x48, 0x58,
+	0x16, 0x9a, 0x55, 0xf9, 0x01, 0xf9, 0xd1, 0x44, 0xcd, 0xae, 0xad, 0xe0, 0x7e, 0x44, 0x9b, 0x9c,
+	0x4e, 0x7b, 0xc6, 0xdc, 0x93, 0xec, 0x0c, 0x3c, 0x67, 0xcf, 0xd9, 0x5f, 0xeb, 0xbc, 0xc8, 0x35,
+	0x5e, 0x06, 0x85, 0xc6, 0xed, 0x19, 0xe5, 0x93, 0x43, 0xb2, 0x24, 0x93, 0xe8, 0x01, 0xa7, 0xd3,
+	0xce, 0x44, 0xf4, 0x3f, 0xb1, 0x33, 0x70, 0x07, 0x68, 0xdb, 0xe2, 0x99, 0x02, 0xd9, 0x4b, 0x21,
+	0x33, 0xc6, 0xf8, 0xc4, 0xbb, 0x63, 0x8b, 0xbe, 0xca, 0x35, 0xae, 0xc3, 0x85, 0xc6, 0xbb, 0x37,
+	0x4a, 0x2f, 0x43, 0x12, 0xb5, 0x4c, 0x80, 0x11, 0xbb, 0x90, 0x75, 0x8c, 0xe4, 0xa6, 0x68, 0x47,
+	0xd1, 0x6c, 0x04, 0xea, 0x56, 0xd0, 0x5d, 0x1b, 0xf4, 0x26, 0xd7, 0xf8, 0x3f, 0x8e, 0x42, 0xe3,
+	0xa7, 0x65, 0x56, 0x3d, 0x27, 0xd1, 0x76, 0x09, 0x96, 0x13, 0x8f, 0x51, 0x2b, 0x03, 0x05, 0x89,
+	0x62, 0x22, 0x29, 0x8d, 0xd2, 0x5b, 0xb3, 0x59, 0x61, 0xae, 0xf1, 0x2d, 0x56, 0x68, 0xfc, 0xa8,
+	0x4c, 0x59, 0x25, 0x24, 0x7a, 0xf8, 0x4f, 0xb2, 0xa5, 0xa5, 0xfb, 0xd5, 0x41, 0x2d, 0xce, 0x92,
+	0xde, 0x10, 0xa0, 0xec, 0x63, 0xa6, 0xc0, 0xbb, 0xb7, 0xe7, 0xec, 0x6f, 0x74, 0x3e, 0x5f, 0x68,
+	0xdc, 0xf8, 0xa5, 0xf1, 0xf3, 0x11, 0x53, 0xe3, 0xd3, 0x7e, 0x10, 0x0b, 0x1e, 0xc6, 0x42, 0x72,
+	0x21, 0xaf, 0x7e, 0x0e, 0xe4, 0xe0, 0x24, 0x54, 0xb3, 0x14, 0x64, 0x70, 0x04, 0xb1, 0x69, 0x65,
+	0xb5, 0x52, 0xd5, 0xca, 0x2a, 0x21, 0xd1, 0x26, 0x67, 0xc9, 0x7b, 0x00, 0xb3, 0xcb, 0x99, 0x02,
+	0xf7, 0x0b, 0xda, 0x31, 0x3c, 0x1e, 0xd3, 0x64, 0x04, 0xbd, 0x01, 0x24, 0x82, 0xb3, 0x84, 0x2a,
+	0x91, 0x79, 0xcd, 0xea, 0x58, 0xeb, 0x1d, 0xd5, 0xb1, 0xd6, 0x73, 0x12, 0xb5, 0x87, 0x00, 0xef,
+	0xac, 0x7e, 0x54, 0xc9, 0xee, 0x5b, 0xb4, 0x31, 0x84, 0x2b, 0xa7, 0x77, 0xdf, 0xee, 0xf9, 0x59,
+	0xae, 0x71, 0x25, 0x16, 0x1a, 0xb7, 0xaa, 0xc2, 0x56, 0x22, 0xd1, 0xfa, 0x10, 0xca, 0x2a, 0xee,
+	0x18, 0xb5, 0xcd, 0xcc, 0xa4, 0xd9, 0x69, 0xb2, 0x34, 0x07, 0xeb, 0xb6, 0xe1, 0xd7, 0xb9, 0xc6,
+	0xb5, 0xbc, 0xd0, 0xf8, 0x49, 0x35, 0x71, 0xab, 0x94, 0x44, 0x5b, 0x9c, 0x4e, 0xbb, 0x56, 0xbd,
+	0x9e, 0x80, 0xc3, 0xf5, 0xef, 0xe7, 0xb8, 0xf1, 0xe7, 0x1c, 0x3b, 0x9d, 0x0f, 0x17, 0x73, 0xdf,
+	0xb9, 0x9c, 0xfb, 0xce, 0xef, 0xb9, 0xef, 0x7c, 0x5b, 0xf8, 0x8d, 0xcb, 0x85, 0xdf, 0xf8, 0xb9,
+	0xf0, 0x1b, 0xc7, 0xc1, 0x8d, 0x6b, 0x92, 0xc0, 0x0e, 0xae, 0x1f, 0xa5, 0xfd, 0x63, 0x5f, 0x65,
+	0x38, 0x0d, 0xed, 0xfb, 0xb5, 0x57, 0xd6, 0x6f, 0x5a, 0xc3, 0xcb, 0xbf, 0x03, 0x00, 0xe7, 0x4f,
+	0x3e, 0x0f, 0xd4, 0x03, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxBlobSize != that1.MaxBlobSize {
+		return false
+	}
+	if this.MaxBytesPerBlock != that1.MaxBytesPerBlock {
+		return false
+	}
+	if this.TargetBytesPerBlock != that1.TargetBytesPerBlock {
+		return false
+	}
+	if this.RetentionBlocks != that1.RetentionBlocks {
+		return false
+	}
+	if !this.MinFeePerByte.Equal(that1.MinFeePerByte) {
+		return false
+	}
+	if this.FeeChangeDenominator != that1.FeeChangeDenominator {
+		return false
+	}
+	if this.FeeDenom != that1.FeeDenom {
+		return false
+	}
+	if this.MaxPrunesPerBlock != that1.MaxPrunesPerBlock {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FeeChangeDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeChangeDenominator))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinFeePerByte.Size()
+		i -= size
+		if _, err := m.MinFeePerByte.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetBytesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetBytesPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBytesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBytesPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBlobSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlobSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlobSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlobSize))
+	}
+	if m.MaxBytesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxBytesPerBlock))
+	}
+	if m.TargetBytesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.TargetBytesPerBlock))
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RetentionBlocks))
+	}
+	l = m.MinFeePerByte.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.FeeChangeDenominator))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunesPerBlock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			}
+			m.MaxBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesPerBlock", wireType)
+			}
+			m.MaxBytesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBytesPerBlock", wireType)
+			}
+			m.TargetBytesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBytesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePerByte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeChangeDenominator", wireType)
+			}
+			m.FeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
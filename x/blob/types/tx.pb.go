@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blob/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSubmitBlob stores data under its sha256 commitment. The sender pays
+// len(data) times the current fee per byte, which is burned. The message fails
+// if the current fee per byte is above max_fee_per_byte.
+type MsgSubmitBlob struct {
+	Sender        string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Data          []byte                                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	MaxFeePerByte github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_fee_per_byte,json=maxFeePerByte,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_per_byte" yaml:"max_fee_per_byte"`
+}
+
+func (m *MsgSubmitBlob) Reset()         { *m = MsgSubmitBlob{} }
+func (m *MsgSubmitBlob) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBlob) ProtoMessage()    {}
+func (*MsgSubmitBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f945cb94fe124aae, []int{0}
+}
+func (m *MsgSubmitBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBlob.Merge(m, src)
+}
+func (m *MsgSubmitBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBlob proto.InternalMessageInfo
+
+func (m *MsgSubmitBlob) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MsgSubmitBlobResponse returns the commitment of the stored blob and the fee
+// charged for it.
+type MsgSubmitBlobResponse struct {
+	Commitment string     `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty" yaml:"commitment"`
+	Fee        types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+}
+
+func (m *MsgSubmitBlobResponse) Reset()         { *m = MsgSubmitBlobResponse{} }
+func (m *MsgSubmitBlobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBlobResponse) ProtoMessage()    {}
+func (*MsgSubmitBlobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f945cb94fe124aae, []int{1}
+}
+func (m *MsgSubmitBlobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBlobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBlobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBlobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBlobResponse.Merge(m, src)
+}
+func (m *MsgSubmitBlobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBlobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBlobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBlobResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitBlobResponse) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *MsgSubmitBlobResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgSubmitBlob)(nil), "seiprotocol.seichain.blob.MsgSubmitBlob")
+	proto.RegisterType((*MsgSubmitBlobResponse)(nil), "seiprotocol.seichain.blob.MsgSubmitBlobResponse")
+}
+
+func init() { proto.RegisterFile("blob/tx.proto", fileDescriptor_f945cb94fe124aae) }
+
+var fileDescriptor_f945cb94fe124aae = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0x71, 0xa5, 0xe0, 0xd4, 0x45, 0x3b, 0x58, 0xdc, 0xee, 0x21, 0x29, 0x11, 0x64, 0x3d,
+	0x74, 0xc6, 0x56, 0xbc, 0x78, 0x11, 0xa2, 0x88, 0x1e, 0x0a, 0x12, 0x6f, 0x5e, 0x96, 0x99, 0xec,
+	0xdb, 0xec, 0xe0, 0x4e, 0x26, 0x64, 0xa6, 0x92, 0xfd, 0x15, 0xfa, 0xb3, 0x7a, 0x2c, 0x78, 0x11,
+	0x0f, 0x41, 0x76, 0xff, 0x41, 0x7e, 0x81, 0xcc, 0x4c, 0x8a, 0x5b, 0x41, 0xe8, 0x29, 0xef, 0xbd,
+	0xef, 0x7b, 0x1f, 0xef, 0xfb, 0x32, 0x78, 0x24, 0x56, 0x5a, 0x30, 0xdb, 0xd0, 0xaa, 0xd6, 0x56,
+	0x93, 0x23, 0x03, 0xd2, 0x57, 0xb9, 0x5e, 0x51, 0x03, 0x32, 0x5f, 0x72, 0x59, 0x52, 0xc7, 0x99,
+	0x3c, 0x2a, 0x74, 0xa1, 0x3d, 0xc6, 0x5c, 0x15, 0x16, 0x26, 0x51, 0xae, 0x8d, 0xd2, 0x86, 0x09,
+	0x6e, 0x80, 0x7d, 0x3d, 0x15, 0x60, 0xf9, 0x29, 0xcb, 0xb5, 0x2c, 0x03, 0x9e, 0xfc, 0x40, 0x78,
+	0x74, 0x6e, 0x8a, 0x4f, 0x17, 0x42, 0x49, 0x9b, 0xae, 0xb4, 0x20, 0xcf, 0xf0, 0x9e, 0x81, 0x72,
+	0x0e, 0xf5, 0x18, 0x1d, 0xa3, 0xe9, 0xbd, 0xf4, 0xa0, 0x6b, 0xe3, 0xd1, 0x9a, 0xab, 0xd5, 0xab,
+	0x24, 0xcc, 0x93, 0xac, 0x27, 0x90, 0x27, 0xf8, 0xee, 0x9c, 0x5b, 0x3e, 0xbe, 0x73, 0x8c, 0xa6,
+	0xf7, 0xd3, 0x07, 0x5d, 0x1b, 0xef, 0x07, 0xa2, 0x9b, 0x26, 0x99, 0x07, 0x49, 0x8d, 0x1f, 0x2a,
+	0xde, 0xcc, 0x16, 0x00, 0xb3, 0x0a, 0xea, 0x99, 0x58, 0x5b, 0x18, 0x0f, 0xbd, 0xf2, 0x87, 0xcb,
+	0x36, 0x1e, 0xfc, 0x6a, 0xe3, 0xa7, 0x85, 0xb4, 0xcb, 0x0b, 0x41, 0x73, 0xad, 0x58, 0x7f, 0x6e,
+	0xf8, 0x9c, 0x98, 0xf9, 0x17, 0x66, 0xd7, 0x15, 0x18, 0xfa, 0x16, 0xf2, 0xae, 0x8d, 0x1f, 0x07,
+	0xf9, 0x7f, 0xf5, 0x92, 0x6c, 0xa4, 0x78, 0xf3, 0x0e, 0xe0, 0x23, 0xd4, 0xa9, 0xeb, 0xbf, 0x21,
+	0x7c, 0x78, 0xc3, 0x55, 0x06, 0xa6, 0xd2, 0xa5, 0x01, 0xf2, 0x12, 0xe3, 0x5c, 0x2b, 0x25, 0xad,
+	0x82, 0xd2, 0xf6, 0x0e, 0x0f, 0xbb, 0x36, 0x3e, 0x08, 0xca, 0x7f, 0xb1, 0x24, 0xdb, 0x21, 0x92,
+	0xd7, 0x78, 0xb8, 0x00, 0xf0, 0x46, 0xf7, 0xcf, 0x8e, 0x68, 0x38, 0x8f, 0xba, 0x50, 0x69, 0x1f,
+	0x2a, 0x7d, 0xa3, 0x65, 0x99, 0x12, 0x67, 0xa9, 0x6b, 0x63, 0x1c, 0xe4, 0x16, 0x00, 0x49, 0xe6,
+	0x36, 0xcf, 0x34, 0x1e, 0x9e, 0x9b, 0x82, 0x2c, 0x31, 0xde, 0x89, 0x7a, 0x4a, 0xff, 0xfb, 0x3b,
+	0xe9, 0x8d, 0xf3, 0x27, 0xcf, 0x6f, 0xcb, 0xbc, 0x36, 0x9a, 0xbe, 0xbf, 0xdc, 0x44, 0xe8, 0x6a,
+	0x13, 0xa1, 0xdf, 0x9b, 0x08, 0x7d, 0xdf, 0x46, 0x83, 0xab, 0x6d, 0x34, 0xf8, 0xb9, 0x8d, 0x06,
+	0x9f, 0xe9, 0x4e, 0xdc, 0x06, 0xe4, 0xc9, 0xb5, 0xac, 0x6f, 0xbc, 0x2e, 0x6b, 0x58, 0x78, 0x76,
+	0x2e, 0x7a, 0xb1, 0xe7, 0x09, 0x2f, 0xfe, 0x0c, 0x00, 0x99, 0x91, 0xd0, 0xee, 0x8b, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SubmitBlob(ctx context.Context, in *MsgSubmitBlob, opts ...grpc.CallOption) (*MsgSubmitBlobResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitBlob(ctx context.Context, in *MsgSubmitBlob, opts ...grpc.CallOption) (*MsgSubmitBlobResponse, error) {
+	out := new(MsgSubmitBlobResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.blob.Msg/SubmitBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SubmitBlob(context.Context, *MsgSubmitBlob) (*MsgSubmitBlobResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitBlob(ctx context.Context, req *MsgSubmitBlob) (*MsgSubmitBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlob not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBlob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.blob.Msg/SubmitBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBlob(ctx, req.(*MsgSubmitBlob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.blob.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBlob",
+			Handler:    _Msg_SubmitBlob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blob/tx.proto",
+}
+
+func (m *MsgSubmitBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeePerByte.Size()
+		i -= size
+		if _, err := m.MaxFeePerByte.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBlobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxFeePerByte.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitBlobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerByte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBlobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdGetMarketParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-market-params [contract address] [price denom] [asset denom]",
+		Short: "Query Market Params",
+		Long: strings.TrimSpace(`
+			Show the tick sizes, order limits and gas charges of a registered pair.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			contractAddr := args[0]
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetMarketParamsRequest{
+				ContractAddr: contractAddr,
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+			}
+
+			res, err := queryClient.GetMarketParams(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
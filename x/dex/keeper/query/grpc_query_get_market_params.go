@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetMarketParams(c context.Context, req *types.QueryGetMarketParamsRequest) (res *types.QueryGetMarketParamsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetRegisteredPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "pair not registered")
+	}
+	params := k.GetParams(ctx)
+
+	// the dependency traversal panics on a broken or cyclic dependency graph,
+	// which fails the tx in the ante handler but must not crash a query
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, status.Error(codes.Internal, fmt.Sprint(r))
+		}
+	}()
+	// the same count the dex gas ante decorator multiplies the per order and
+	// per cancel gas by
+	numDependencies := len(dexcache.GetAllDownstreamContracts(ctx, req.ContractAddr, k.GetContractWithoutGasCharge))
+
+	return &types.QueryGetMarketParamsResponse{
+		Pair:                       pair,
+		MaxOrderPerPrice:           params.MaxOrderPerPrice,
+		DefaultGasPerOrder:         params.DefaultGasPerOrder,
+		DefaultGasPerCancel:        params.DefaultGasPerCancel,
+		DefaultGasPerOrderDataByte: params.DefaultGasPerOrderDataByte,
+		NumDependencies:            uint64(numDependencies),
+	}, nil
+}
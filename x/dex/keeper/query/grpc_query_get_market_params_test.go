@@ -0,0 +1,77 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetMarketParamsQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	pair := types.Pair{
+		PriceDenom:       keepertest.TestPriceDenom,
+		AssetDenom:       keepertest.TestAssetDenom,
+		PriceTicksize:    &keepertest.TestTicksize,
+		QuantityTicksize: &keepertest.TestTicksize,
+	}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	params := keeper.GetParams(ctx)
+
+	request := types.QueryGetMarketParamsRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	}
+	response, err := wrapper.GetMarketParams(wctx, &request)
+	require.NoError(t, err)
+	require.Equal(t, types.QueryGetMarketParamsResponse{
+		Pair:                       pair,
+		MaxOrderPerPrice:           params.MaxOrderPerPrice,
+		DefaultGasPerOrder:         params.DefaultGasPerOrder,
+		DefaultGasPerCancel:        params.DefaultGasPerCancel,
+		DefaultGasPerOrderDataByte: params.DefaultGasPerOrderDataByte,
+		NumDependencies:            1,
+	}, *response)
+
+	// orders for the contract also run its downstream contracts
+	const downstream = "sei1jv65s3grqf6v6jl3dp4t6c9t9rk99cd82n4207"
+	require.NoError(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  1000000,
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: downstream}},
+	}))
+	require.NoError(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: downstream,
+		CodeId:       1,
+		RentBalance:  1000000,
+	}))
+	response, err = wrapper.GetMarketParams(wctx, &request)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), response.NumDependencies)
+
+	// a dependency cycle fails the query instead of panicking
+	require.NoError(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: downstream,
+		CodeId:       1,
+		RentBalance:  1000000,
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: keepertest.TestContract}},
+	}))
+	_, err = wrapper.GetMarketParams(wctx, &request)
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	request.AssetDenom = "unregistered"
+	_, err = wrapper.GetMarketParams(wctx, &request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -1346,6 +1346,155 @@ func (m *QueryRegisteredContractResponse) GetContractInfo() *ContractInfoV2 {
 	return nil
 }
 
+type QueryGetMarketParamsRequest struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	PriceDenom   string `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom   string `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+}
+
+func (m *QueryGetMarketParamsRequest) Reset()         { *m = QueryGetMarketParamsRequest{} }
+func (m *QueryGetMarketParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketParamsRequest) ProtoMessage()    {}
+func (*QueryGetMarketParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{26}
+}
+func (m *QueryGetMarketParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketParamsRequest.Merge(m, src)
+}
+func (m *QueryGetMarketParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketParamsRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketParamsRequest) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *QueryGetMarketParamsRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *QueryGetMarketParamsRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+type QueryGetMarketParamsResponse struct {
+	Pair                Pair   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	MaxOrderPerPrice    uint64 `protobuf:"varint,2,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price"`
+	DefaultGasPerOrder  uint64 `protobuf:"varint,3,opt,name=default_gas_per_order,json=defaultGasPerOrder,proto3" json:"default_gas_per_order"`
+	DefaultGasPerCancel uint64 `protobuf:"varint,4,opt,name=default_gas_per_cancel,json=defaultGasPerCancel,proto3" json:"default_gas_per_cancel"`
+	// default_gas_per_order_data_byte is charged for every byte of an order's
+	// data on top of the per order gas
+	DefaultGasPerOrderDataByte uint64 `protobuf:"varint,5,opt,name=default_gas_per_order_data_byte,json=defaultGasPerOrderDataByte,proto3" json:"default_gas_per_order_data_byte"`
+	// num_dependencies is the number of contracts an order or cancellation
+	// for the market runs, i.e. the contract itself and its downstream
+	// dependencies. The per order and per cancel gas is charged once for each.
+	NumDependencies uint64 `protobuf:"varint,6,opt,name=num_dependencies,json=numDependencies,proto3" json:"num_dependencies"`
+}
+
+func (m *QueryGetMarketParamsResponse) Reset()         { *m = QueryGetMarketParamsResponse{} }
+func (m *QueryGetMarketParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketParamsResponse) ProtoMessage()    {}
+func (*QueryGetMarketParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8e98105e6e08a59, []int{27}
+}
+func (m *QueryGetMarketParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketParamsResponse.Merge(m, src)
+}
+func (m *QueryGetMarketParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketParamsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketParamsResponse) GetPair() Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return Pair{}
+}
+
+func (m *QueryGetMarketParamsResponse) GetMaxOrderPerPrice() uint64 {
+	if m != nil {
+		return m.MaxOrderPerPrice
+	}
+	return 0
+}
+
+func (m *QueryGetMarketParamsResponse) GetDefaultGasPerOrder() uint64 {
+	if m != nil {
+		return m.DefaultGasPerOrder
+	}
+	return 0
+}
+
+func (m *QueryGetMarketParamsResponse) GetDefaultGasPerCancel() uint64 {
+	if m != nil {
+		return m.DefaultGasPerCancel
+	}
+	return 0
+}
+
+func (m *QueryGetMarketParamsResponse) GetDefaultGasPerOrderDataByte() uint64 {
+	if m != nil {
+		return m.DefaultGasPerOrderDataByte
+	}
+	return 0
+}
+
+func (m *QueryGetMarketParamsResponse) GetNumDependencies() uint64 {
+	if m != nil {
+		return m.NumDependencies
+	}
+	return 0
+}
+
 type QueryGetOrdersRequest struct {
 	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
 	Account      string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
@@ -1355,7 +1504,7 @@ func (m *QueryGetOrdersRequest) Reset()         { *m = QueryGetOrdersRequest{} }
 func (m *QueryGetOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrdersRequest) ProtoMessage()    {}
 func (*QueryGetOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{26}
+	return fileDescriptor_d8e98105e6e08a59, []int{28}
 }
 func (m *QueryGetOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,7 +1555,7 @@ func (m *QueryGetOrdersResponse) Reset()         { *m = QueryGetOrdersResponse{}
 func (m *QueryGetOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrdersResponse) ProtoMessage()    {}
 func (*QueryGetOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{27}
+	return fileDescriptor_d8e98105e6e08a59, []int{29}
 }
 func (m *QueryGetOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1602,7 @@ func (m *QueryGetOrderByIDRequest) Reset()         { *m = QueryGetOrderByIDReque
 func (m *QueryGetOrderByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrderByIDRequest) ProtoMessage()    {}
 func (*QueryGetOrderByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{28}
+	return fileDescriptor_d8e98105e6e08a59, []int{30}
 }
 func (m *QueryGetOrderByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1518,7 +1667,7 @@ func (m *QueryGetOrderByIDResponse) Reset()         { *m = QueryGetOrderByIDResp
 func (m *QueryGetOrderByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrderByIDResponse) ProtoMessage()    {}
 func (*QueryGetOrderByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{29}
+	return fileDescriptor_d8e98105e6e08a59, []int{31}
 }
 func (m *QueryGetOrderByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1566,7 +1715,7 @@ func (m *QueryGetHistoricalPricesRequest) Reset()         { *m = QueryGetHistori
 func (m *QueryGetHistoricalPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetHistoricalPricesRequest) ProtoMessage()    {}
 func (*QueryGetHistoricalPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{30}
+	return fileDescriptor_d8e98105e6e08a59, []int{32}
 }
 func (m *QueryGetHistoricalPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,7 +1787,7 @@ func (m *QueryGetHistoricalPricesResponse) Reset()         { *m = QueryGetHistor
 func (m *QueryGetHistoricalPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetHistoricalPricesResponse) ProtoMessage()    {}
 func (*QueryGetHistoricalPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{31}
+	return fileDescriptor_d8e98105e6e08a59, []int{33}
 }
 func (m *QueryGetHistoricalPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1834,7 @@ func (m *QueryGetMarketSummaryRequest) Reset()         { *m = QueryGetMarketSumm
 func (m *QueryGetMarketSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketSummaryRequest) ProtoMessage()    {}
 func (*QueryGetMarketSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{32}
+	return fileDescriptor_d8e98105e6e08a59, []int{34}
 }
 func (m *QueryGetMarketSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +1903,7 @@ func (m *QueryGetMarketSummaryResponse) Reset()         { *m = QueryGetMarketSum
 func (m *QueryGetMarketSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketSummaryResponse) ProtoMessage()    {}
 func (*QueryGetMarketSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{33}
+	return fileDescriptor_d8e98105e6e08a59, []int{35}
 }
 func (m *QueryGetMarketSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1941,7 @@ func (m *QueryOrderSimulationRequest) Reset()         { *m = QueryOrderSimulatio
 func (m *QueryOrderSimulationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderSimulationRequest) ProtoMessage()    {}
 func (*QueryOrderSimulationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{34}
+	return fileDescriptor_d8e98105e6e08a59, []int{36}
 }
 func (m *QueryOrderSimulationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,7 +1992,7 @@ func (m *QueryOrderSimulationResponse) Reset()         { *m = QueryOrderSimulati
 func (m *QueryOrderSimulationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderSimulationResponse) ProtoMessage()    {}
 func (*QueryOrderSimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{35}
+	return fileDescriptor_d8e98105e6e08a59, []int{37}
 }
 func (m *QueryOrderSimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1880,7 +2029,7 @@ func (m *QueryGetMatchResultRequest) Reset()         { *m = QueryGetMatchResultR
 func (m *QueryGetMatchResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMatchResultRequest) ProtoMessage()    {}
 func (*QueryGetMatchResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{36}
+	return fileDescriptor_d8e98105e6e08a59, []int{38}
 }
 func (m *QueryGetMatchResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1924,7 +2073,7 @@ func (m *QueryGetMatchResultResponse) Reset()         { *m = QueryGetMatchResult
 func (m *QueryGetMatchResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMatchResultResponse) ProtoMessage()    {}
 func (*QueryGetMatchResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{37}
+	return fileDescriptor_d8e98105e6e08a59, []int{39}
 }
 func (m *QueryGetMatchResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2121,7 @@ func (m *QueryGetOrderCountRequest) Reset()         { *m = QueryGetOrderCountReq
 func (m *QueryGetOrderCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrderCountRequest) ProtoMessage()    {}
 func (*QueryGetOrderCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{38}
+	return fileDescriptor_d8e98105e6e08a59, []int{40}
 }
 func (m *QueryGetOrderCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2037,7 +2186,7 @@ func (m *QueryGetOrderCountResponse) Reset()         { *m = QueryGetOrderCountRe
 func (m *QueryGetOrderCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOrderCountResponse) ProtoMessage()    {}
 func (*QueryGetOrderCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8e98105e6e08a59, []int{39}
+	return fileDescriptor_d8e98105e6e08a59, []int{41}
 }
 func (m *QueryGetOrderCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2100,6 +2249,8 @@ func init() {
 	proto.RegisterType((*QueryRegisteredPairsResponse)(nil), "seiprotocol.seichain.dex.QueryRegisteredPairsResponse")
 	proto.RegisterType((*QueryRegisteredContractRequest)(nil), "seiprotocol.seichain.dex.QueryRegisteredContractRequest")
 	proto.RegisterType((*QueryRegisteredContractResponse)(nil), "seiprotocol.seichain.dex.QueryRegisteredContractResponse")
+	proto.RegisterType((*QueryGetMarketParamsRequest)(nil), "seiprotocol.seichain.dex.QueryGetMarketParamsRequest")
+	proto.RegisterType((*QueryGetMarketParamsResponse)(nil), "seiprotocol.seichain.dex.QueryGetMarketParamsResponse")
 	proto.RegisterType((*QueryGetOrdersRequest)(nil), "seiprotocol.seichain.dex.QueryGetOrdersRequest")
 	proto.RegisterType((*QueryGetOrdersResponse)(nil), "seiprotocol.seichain.dex.QueryGetOrdersResponse")
 	proto.RegisterType((*QueryGetOrderByIDRequest)(nil), "seiprotocol.seichain.dex.QueryGetOrderByIDRequest")
@@ -2119,150 +2270,162 @@ func init() {
 func init() { proto.RegisterFile("dex/query.proto", fileDescriptor_d8e98105e6e08a59) }
 
 var fileDescriptor_d8e98105e6e08a59 = []byte{
-	// 2281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
-	0x1d, 0x76, 0x8f, 0x63, 0x63, 0x97, 0xf3, 0x2c, 0xdb, 0x89, 0x19, 0xc2, 0x4c, 0xa8, 0x55, 0x36,
-	0xcb, 0x2e, 0x9e, 0x26, 0x4e, 0xb2, 0x79, 0x48, 0x9b, 0x6c, 0xc6, 0xce, 0x1a, 0x8b, 0x38, 0x71,
-	0xda, 0x89, 0x37, 0x84, 0x0d, 0xbd, 0xe5, 0xe9, 0xf2, 0x4c, 0xe3, 0x9e, 0xae, 0x49, 0x77, 0xcf,
-	0xc6, 0x96, 0x19, 0xf1, 0x12, 0x17, 0xb8, 0x44, 0x5a, 0x0e, 0xec, 0x81, 0x3f, 0x00, 0x09, 0x0e,
-	0x5c, 0xd0, 0x8a, 0x3b, 0xab, 0x95, 0x40, 0x4b, 0xa4, 0x05, 0x09, 0x81, 0x34, 0x42, 0x09, 0xa7,
-	0xb9, 0x23, 0xc4, 0x6d, 0xd5, 0x55, 0xd5, 0xef, 0x19, 0x77, 0x77, 0x1c, 0xad, 0xb2, 0xa7, 0xf6,
-	0x54, 0xd7, 0xf7, 0xab, 0xdf, 0xf7, 0xd5, 0xaf, 0x1e, 0xfd, 0x19, 0x1c, 0xd2, 0xc8, 0x96, 0xfc,
-	0xa0, 0x4d, 0xac, 0xed, 0x4a, 0xcb, 0xa2, 0x0e, 0x85, 0x33, 0x36, 0xd1, 0xd9, 0x5f, 0x35, 0x6a,
-	0x54, 0x6c, 0xa2, 0xd7, 0x1a, 0x58, 0x37, 0x2b, 0x1a, 0xd9, 0x2a, 0x4e, 0xd5, 0x69, 0x9d, 0xb2,
-	0x57, 0xb2, 0xfb, 0x17, 0xef, 0x5f, 0x3c, 0x5e, 0xa7, 0xb4, 0x6e, 0x10, 0x19, 0xb7, 0x74, 0x19,
-	0x9b, 0x26, 0x75, 0xb0, 0xa3, 0x53, 0xd3, 0x16, 0x6f, 0x5f, 0xad, 0x51, 0xbb, 0x49, 0x6d, 0x79,
-	0x1d, 0xdb, 0x84, 0x0f, 0x23, 0xbf, 0x77, 0x7a, 0x9d, 0x38, 0xf8, 0xb4, 0xdc, 0xc2, 0x75, 0xdd,
-	0x64, 0x9d, 0x45, 0xdf, 0xc3, 0x6e, 0x2a, 0x2d, 0x6c, 0xe1, 0xa6, 0x87, 0x9e, 0x74, 0x5b, 0x0c,
-	0x6a, 0xd6, 0xd5, 0x75, 0x4a, 0x37, 0x45, 0xe3, 0x94, 0xdb, 0x68, 0x37, 0xa8, 0xe5, 0x84, 0x5b,
-	0x19, 0x8f, 0x96, 0xa5, 0xd7, 0x88, 0x68, 0x80, 0x6e, 0x43, 0x8d, 0x9a, 0x8e, 0x85, 0x6b, 0x8e,
-	0x68, 0x3b, 0xe8, 0xb6, 0x39, 0x0f, 0x71, 0x2b, 0x1c, 0x0a, 0xdb, 0x36, 0x71, 0x54, 0x43, 0xb7,
-	0x23, 0xbd, 0x5a, 0x58, 0xb7, 0xc2, 0xa1, 0xa9, 0xa5, 0x11, 0xaf, 0xe1, 0xa8, 0xdb, 0xd0, 0xc4,
-	0x4e, 0xad, 0xa1, 0x5a, 0xc4, 0x6e, 0x1b, 0x4e, 0xb8, 0x23, 0x31, 0xdb, 0x5e, 0xfe, 0x68, 0x0a,
-	0xc0, 0x5b, 0x2e, 0xe7, 0x15, 0x46, 0x4a, 0x21, 0x0f, 0xda, 0xc4, 0x76, 0xd0, 0x1d, 0x30, 0x19,
-	0x69, 0xb5, 0x5b, 0xd4, 0xb4, 0x09, 0xbc, 0x0c, 0x46, 0x39, 0xf9, 0x19, 0xe9, 0x84, 0xf4, 0xca,
-	0xc4, 0xdc, 0x89, 0xca, 0xa0, 0x99, 0xa8, 0x70, 0x64, 0x75, 0xdf, 0xc7, 0xdd, 0xf2, 0x90, 0x22,
-	0x50, 0xe8, 0x7d, 0x09, 0x1c, 0x63, 0x71, 0x17, 0x89, 0x73, 0x9d, 0x9a, 0xf5, 0x2a, 0xa5, 0x9b,
-	0x62, 0x48, 0x38, 0x05, 0x46, 0x98, 0x36, 0x2c, 0xf4, 0xb8, 0xc2, 0x7f, 0x40, 0x04, 0xf6, 0x7b,
-	0x02, 0x5d, 0xd5, 0x34, 0x6b, 0xa6, 0xc0, 0x5e, 0x46, 0xda, 0x60, 0x09, 0x00, 0xd6, 0x79, 0x81,
-	0x98, 0xb4, 0x39, 0x33, 0xcc, 0x7a, 0x84, 0x5a, 0xdc, 0xf7, 0x4c, 0x40, 0xfe, 0x7e, 0x1f, 0x7f,
-	0x1f, 0xb4, 0xa0, 0x77, 0xc1, 0x4c, 0x32, 0x29, 0xc1, 0x78, 0x01, 0x8c, 0x79, 0x6d, 0x82, 0x33,
-	0x1a, 0xcc, 0xd9, 0xeb, 0x29, 0x58, 0xfb, 0x48, 0xf4, 0x27, 0x8f, 0xf7, 0x55, 0xc3, 0x88, 0xf3,
-	0x7e, 0x0b, 0x80, 0xa0, 0xcc, 0xc4, 0x18, 0x2f, 0x57, 0x78, 0x4d, 0x56, 0xdc, 0x9a, 0xac, 0xf0,
-	0xd2, 0x17, 0x35, 0x59, 0x59, 0xc1, 0x75, 0x22, 0xb0, 0x4a, 0x08, 0xf9, 0xb9, 0x28, 0xf5, 0x1b,
-	0x49, 0x48, 0x15, 0xe1, 0xd1, 0x57, 0xaa, 0xe1, 0x67, 0x93, 0x0a, 0x2e, 0x46, 0xe4, 0x28, 0x30,
-	0x39, 0x4e, 0xa5, 0xca, 0xc1, 0x53, 0x08, 0xeb, 0x81, 0x7e, 0x29, 0x05, 0xd3, 0xba, 0xea, 0x2e,
-	0xc5, 0x17, 0xa3, 0xd8, 0x34, 0xf0, 0xe5, 0x3e, 0x59, 0x09, 0x09, 0x17, 0xc1, 0xb8, 0xdf, 0x28,
-	0x4a, 0xe1, 0xa5, 0xc1, 0x1a, 0xfa, 0x5d, 0x85, 0x88, 0x01, 0x16, 0x7d, 0x14, 0x9a, 0xa8, 0x04,
-	0xf9, 0x2f, 0x52, 0xc5, 0xfd, 0x4e, 0x12, 0x7a, 0x45, 0x89, 0xf4, 0xd7, 0x6b, 0xf8, 0x59, 0xf5,
-	0x7a, 0x7e, 0x55, 0xb7, 0x03, 0xa6, 0xbd, 0xe9, 0x5d, 0x71, 0x59, 0x7a, 0x3b, 0x6a, 0x4c, 0x08,
-	0x29, 0x45, 0x88, 0x42, 0x5c, 0x88, 0x84, 0xd8, 0xc3, 0x49, 0xb1, 0xd1, 0x2d, 0x70, 0x34, 0x3e,
-	0xb8, 0x10, 0xea, 0x3c, 0x18, 0x65, 0x63, 0xd9, 0x42, 0xa5, 0xf2, 0x2e, 0x1b, 0xb7, 0xdb, 0x4f,
-	0x11, 0xdd, 0xd1, 0xaf, 0x24, 0x30, 0x15, 0x89, 0xf9, 0x39, 0xf2, 0x81, 0xc7, 0xc1, 0xb8, 0xa3,
-	0x37, 0x89, 0xed, 0xe0, 0x66, 0x8b, 0xd5, 0xc6, 0x3e, 0x25, 0x68, 0x40, 0x5a, 0x4c, 0x6a, 0x9f,
-	0xec, 0xb9, 0xf0, 0xe2, 0xce, 0xc0, 0x55, 0xac, 0xfe, 0x29, 0x30, 0xb2, 0x41, 0xdb, 0xa6, 0xc6,
-	0x92, 0x1d, 0x53, 0xf8, 0x0f, 0xf4, 0xa1, 0x04, 0x8a, 0xfe, 0xe9, 0x80, 0x1d, 0x62, 0x47, 0x65,
-	0x90, 0x93, 0x32, 0x54, 0x0f, 0xf5, 0xba, 0xe5, 0x09, 0xd6, 0xaa, 0x6a, 0x6e, 0x73, 0x44, 0x17,
-	0x39, 0xa9, 0x0b, 0x07, 0xf0, 0x33, 0x5e, 0x00, 0x42, 0x42, 0x5d, 0xe8, 0x27, 0x54, 0x75, 0xaa,
-	0xd7, 0x2d, 0x1f, 0xf6, 0xda, 0x55, 0xac, 0x69, 0x16, 0xb1, 0xed, 0x58, 0x39, 0xdc, 0x06, 0x5f,
-	0xe9, 0x9b, 0xf9, 0x9e, 0x64, 0x42, 0x8f, 0x42, 0x15, 0x71, 0xfb, 0x21, 0x6e, 0xf9, 0x15, 0x1e,
-	0x4f, 0x54, 0xca, 0x9a, 0x28, 0xbc, 0x0c, 0x0e, 0x19, 0x94, 0x6e, 0xae, 0xe3, 0xda, 0xe6, 0x2a,
-	0xa9, 0x51, 0x53, 0xb3, 0x99, 0x30, 0xfb, 0x38, 0xd8, 0x7b, 0xa5, 0xda, 0xfc, 0x9d, 0x12, 0xef,
-	0x8c, 0xee, 0x06, 0x95, 0x20, 0x32, 0x12, 0x14, 0xaf, 0x80, 0x11, 0xf7, 0x2a, 0xe5, 0x55, 0x7d,
-	0x69, 0x30, 0x45, 0x17, 0x57, 0x1d, 0xef, 0x75, 0xcb, 0x1c, 0xa0, 0xf0, 0x07, 0x3a, 0x26, 0x22,
-	0x5f, 0x75, 0xe7, 0xe3, 0xba, 0x6e, 0x3b, 0xde, 0x05, 0x89, 0x88, 0xa5, 0x16, 0x7a, 0x21, 0xc6,
-	0xfc, 0x36, 0x18, 0xc7, 0x5e, 0xa3, 0x18, 0xf7, 0xd4, 0xe0, 0x71, 0x19, 0x7e, 0x99, 0x38, 0x58,
-	0xc3, 0x0e, 0xf6, 0xf6, 0x25, 0x1f, 0x8f, 0x4e, 0x7b, 0xbb, 0x5f, 0xb8, 0x5b, 0xe8, 0x10, 0xd3,
-	0x42, 0xab, 0x8f, 0xff, 0x40, 0x58, 0xd4, 0x6b, 0x0c, 0x22, 0xb2, 0x9b, 0x07, 0x63, 0x4d, 0xd1,
-	0x26, 0xe6, 0x3d, 0x6b, 0x72, 0x8a, 0x0f, 0x44, 0x6f, 0x8b, 0xc2, 0x52, 0x48, 0x5d, 0xb7, 0x1d,
-	0x62, 0x11, 0x6d, 0x05, 0xeb, 0xd6, 0xde, 0x0b, 0x01, 0xdd, 0x03, 0xc7, 0xfb, 0x07, 0x16, 0xd9,
-	0x5f, 0x02, 0x23, 0xee, 0xa5, 0x37, 0xc3, 0x7c, 0xba, 0x38, 0x21, 0x27, 0x87, 0xa0, 0x7b, 0xa0,
-	0x14, 0x8b, 0x3d, 0x2f, 0x86, 0xde, 0x7b, 0xde, 0x2d, 0x50, 0x1e, 0x18, 0x5b, 0xa4, 0xbe, 0x0c,
-	0x0e, 0xf8, 0x41, 0x74, 0x73, 0x83, 0x0a, 0xf5, 0x5f, 0x19, 0x4c, 0xc1, 0x0b, 0xb1, 0x64, 0x6e,
-	0xd0, 0xb5, 0xb9, 0x60, 0x44, 0xf7, 0x37, 0xda, 0x0a, 0x4a, 0xfe, 0xa6, 0x7b, 0xed, 0x7f, 0x0e,
-	0xab, 0xf0, 0x24, 0xf8, 0x12, 0xae, 0xd5, 0x68, 0xdb, 0x74, 0xc4, 0xb6, 0x34, 0xd1, 0xeb, 0x96,
-	0xbd, 0x26, 0xc5, 0xfb, 0x03, 0xdd, 0x0f, 0x0e, 0x19, 0x6f, 0x64, 0xbf, 0xb6, 0x46, 0xd9, 0x27,
-	0x48, 0x86, 0x43, 0x86, 0x21, 0xab, 0xa0, 0xd7, 0x2d, 0x0b, 0x88, 0x22, 0x9e, 0xe8, 0x93, 0xd0,
-	0xb5, 0x8d, 0xf7, 0xda, 0x5e, 0x5a, 0xd8, 0x3b, 0xb9, 0xe8, 0x3e, 0x5d, 0xc8, 0xbb, 0x4f, 0x0f,
-	0xa7, 0xef, 0xd3, 0x47, 0x41, 0x41, 0xd7, 0xf8, 0x29, 0x55, 0x1d, 0xed, 0x75, 0xcb, 0x05, 0x5d,
-	0x53, 0x0a, 0xba, 0x86, 0xee, 0x07, 0x17, 0xbe, 0x10, 0x1f, 0x21, 0xd9, 0x9b, 0x60, 0x84, 0xf1,
-	0x4e, 0xdf, 0x83, 0x39, 0x96, 0xed, 0x50, 0x0c, 0xa1, 0xf0, 0x07, 0xfa, 0x4b, 0x41, 0xd4, 0xde,
-	0x22, 0x71, 0xbe, 0xa5, 0xdb, 0x0e, 0xb5, 0xf4, 0x1a, 0x36, 0xa2, 0x77, 0x8f, 0x17, 0x59, 0x36,
-	0x05, 0x4c, 0xb7, 0x88, 0xa5, 0x53, 0xed, 0x3a, 0x31, 0xeb, 0x4e, 0x63, 0xc9, 0xf4, 0x4e, 0x00,
-	0xae, 0xe4, 0xf1, 0x5e, 0xb7, 0x3c, 0xc3, 0x3b, 0xa8, 0x06, 0xeb, 0xa1, 0xea, 0xa6, 0x7f, 0x12,
-	0xf4, 0x87, 0xc2, 0x8b, 0x60, 0xbf, 0xd9, 0x6e, 0xde, 0xdc, 0x58, 0x61, 0x6f, 0xed, 0x99, 0x11,
-	0x16, 0x6a, 0xba, 0xd7, 0x2d, 0x1f, 0x31, 0xdb, 0xcd, 0x75, 0x62, 0xa9, 0x74, 0x43, 0xe5, 0x50,
-	0x5b, 0x89, 0x74, 0x45, 0x16, 0x38, 0x31, 0x58, 0x4d, 0x31, 0x69, 0x37, 0x62, 0x97, 0xa9, 0x57,
-	0x53, 0x4e, 0xce, 0x79, 0x6c, 0x6a, 0x06, 0xb1, 0x1d, 0xbd, 0xb6, 0xc9, 0x4b, 0x9e, 0xa3, 0xfd,
-	0x3b, 0xd6, 0x8f, 0x0b, 0x62, 0xdb, 0x5b, 0x24, 0xce, 0x32, 0xb6, 0x36, 0x89, 0xb3, 0xda, 0x6e,
-	0x36, 0xb1, 0xbb, 0x9d, 0xbc, 0xf8, 0xf3, 0x77, 0x0d, 0x1c, 0xf1, 0x8e, 0xe3, 0xf8, 0xdc, 0x1d,
-	0xeb, 0x75, 0xcb, 0x93, 0xfe, 0xe9, 0x1d, 0x9a, 0xb6, 0x24, 0x02, 0xfd, 0x7f, 0x18, 0x7c, 0x75,
-	0x80, 0x06, 0x42, 0xf5, 0x77, 0xc0, 0x84, 0x43, 0x1d, 0x6c, 0xac, 0x51, 0xa3, 0xdd, 0x14, 0x1f,
-	0x6e, 0xd5, 0x4b, 0xff, 0xec, 0x96, 0x5f, 0xae, 0xeb, 0x4e, 0xa3, 0xbd, 0x5e, 0xa9, 0xd1, 0xa6,
-	0x2c, 0xac, 0x1c, 0xfe, 0x98, 0xb5, 0xb5, 0x4d, 0xd9, 0xd9, 0x6e, 0x11, 0xbb, 0xb2, 0x40, 0x6a,
-	0xbd, 0x6e, 0x79, 0x3f, 0x0b, 0xa0, 0xbe, 0xc7, 0x22, 0x28, 0xe1, 0x70, 0xb0, 0x0d, 0x26, 0x43,
-	0x3f, 0x6f, 0x50, 0xf7, 0x32, 0x8f, 0x0d, 0xa1, 0xd8, 0x7c, 0xae, 0x51, 0xa6, 0xc3, 0xa3, 0xa8,
-	0xa6, 0x08, 0xa5, 0xf4, 0x8b, 0x0f, 0xd7, 0xc0, 0x78, 0x43, 0xaf, 0x37, 0x58, 0x99, 0x08, 0xb5,
-	0x2f, 0xe4, 0x1a, 0x0c, 0xb8, 0x70, 0x95, 0x4d, 0xa0, 0x12, 0x84, 0x82, 0xab, 0x60, 0xcc, 0xa0,
-	0x0f, 0x79, 0x58, 0xf6, 0x51, 0x55, 0x3d, 0x9f, 0x2b, 0xec, 0xb8, 0x41, 0x1f, 0x8a, 0xa8, 0x7e,
-	0x20, 0x37, 0x59, 0x03, 0x8b, 0x5b, 0x24, 0x5b, 0x53, 0xb9, 0x93, 0x75, 0xe1, 0x5e, 0xb2, 0x7e,
-	0x28, 0xf4, 0x81, 0x24, 0xee, 0x13, 0x6c, 0x8f, 0x5b, 0xd5, 0x9b, 0x6d, 0x83, 0x7d, 0x4c, 0x79,
-	0xe5, 0xbf, 0xe7, 0x4d, 0x32, 0xb1, 0x80, 0x0a, 0x99, 0x4f, 0xf6, 0x5f, 0x48, 0x62, 0x6d, 0x26,
-	0x72, 0x13, 0x65, 0xb9, 0x09, 0x0e, 0x5f, 0xdb, 0x22, 0xb5, 0xb6, 0x43, 0xb4, 0x5b, 0x6d, 0x6c,
-	0x3a, 0xba, 0xb3, 0x2d, 0x6a, 0xf3, 0x4a, 0x2e, 0x6d, 0x8e, 0x10, 0x11, 0x45, 0x7d, 0x20, 0xc2,
-	0x28, 0x89, 0xc0, 0x68, 0x2d, 0xf8, 0x16, 0x59, 0xc6, 0x4e, 0xad, 0xa1, 0x30, 0x6b, 0x6f, 0xef,
-	0xf7, 0x97, 0x46, 0xf0, 0xa5, 0x10, 0x89, 0x2b, 0x38, 0x2e, 0x81, 0x51, 0x6e, 0x22, 0x8a, 0x19,
-	0x38, 0x39, 0x78, 0x06, 0x42, 0x70, 0xbe, 0xd7, 0x71, 0xa0, 0x22, 0x9e, 0xe8, 0xbf, 0x85, 0xd8,
-	0x71, 0x38, 0xcf, 0x6e, 0x17, 0x5f, 0x80, 0x8d, 0x6e, 0xc9, 0xfb, 0x5c, 0xe2, 0xeb, 0xe9, 0x4c,
-	0xae, 0xd9, 0xe5, 0x50, 0xef, 0x4b, 0xf3, 0x01, 0x38, 0xd2, 0xa2, 0xb6, 0xee, 0xd6, 0xd1, 0x82,
-	0x6e, 0x91, 0x1a, 0x33, 0x1d, 0xdc, 0x05, 0x75, 0x70, 0xee, 0xb5, 0x5d, 0xce, 0x92, 0x38, 0xa4,
-	0x7a, 0xb4, 0xd7, 0x2d, 0x43, 0x2f, 0x92, 0xaa, 0x79, 0xed, 0x4a, 0x32, 0x3a, 0x7a, 0x23, 0xa8,
-	0x9c, 0xb0, 0xec, 0x62, 0x82, 0xcb, 0x60, 0x84, 0x5f, 0xfc, 0x24, 0xb6, 0x71, 0xb3, 0x05, 0xc4,
-	0xaf, 0x7d, 0xfc, 0x31, 0xf7, 0xdb, 0x12, 0x18, 0x61, 0x78, 0xf8, 0x48, 0x02, 0xa3, 0xdc, 0xdb,
-	0x85, 0xdf, 0x18, 0x9c, 0x6b, 0xd2, 0x52, 0x2e, 0xce, 0x66, 0xec, 0xcd, 0x53, 0x42, 0x5f, 0xff,
-	0xc9, 0xa7, 0xff, 0x79, 0xbf, 0xf0, 0x12, 0xfc, 0x9a, 0x6c, 0x13, 0x7d, 0xd6, 0xc3, 0xc9, 0x1e,
-	0x4e, 0x0e, 0x8c, 0x78, 0xf8, 0x58, 0x0a, 0x9c, 0x47, 0x78, 0x3a, 0x65, 0x98, 0xa4, 0xf3, 0x5c,
-	0x9c, 0xcb, 0x03, 0x11, 0xe9, 0xdd, 0x67, 0xe9, 0xbd, 0x0d, 0xef, 0xec, 0x92, 0x9e, 0xff, 0x5f,
-	0x01, 0x79, 0x27, 0x5c, 0xab, 0x1d, 0x79, 0x27, 0xa8, 0xc3, 0x8e, 0xbc, 0x13, 0xd4, 0x98, 0xf7,
-	0xa6, 0x03, 0xff, 0x2c, 0x81, 0x09, 0x6f, 0xcc, 0xab, 0x86, 0x91, 0xca, 0x2a, 0xe9, 0x2b, 0xa7,
-	0xb2, 0xea, 0x63, 0xe1, 0xa2, 0x3b, 0x8c, 0xd5, 0x4d, 0xb8, 0xfc, 0x5c, 0x59, 0xc1, 0xbf, 0x49,
-	0x21, 0x9f, 0x0e, 0x66, 0x90, 0x3b, 0x6e, 0x59, 0x16, 0xcf, 0xe4, 0xc2, 0x08, 0x36, 0xdf, 0x63,
-	0x6c, 0xee, 0xc2, 0xb5, 0x5d, 0xd8, 0x04, 0xff, 0xa4, 0xc9, 0x3f, 0x49, 0x7f, 0x95, 0xc0, 0x7e,
-	0x7f, 0x54, 0x77, 0x96, 0x32, 0x48, 0x9e, 0x9b, 0x59, 0x3f, 0xdf, 0x13, 0xad, 0x31, 0x66, 0x2b,
-	0xf0, 0xc6, 0xf3, 0x65, 0x06, 0x3f, 0x91, 0xc0, 0x98, 0x67, 0xa7, 0xc1, 0x4a, 0xba, 0xe6, 0x61,
-	0x2b, 0xac, 0x28, 0x67, 0xee, 0x2f, 0x58, 0x60, 0xc6, 0xe2, 0xbb, 0xf0, 0x3b, 0xbb, 0xb0, 0xa8,
-	0x13, 0x71, 0x61, 0xc8, 0x31, 0x3d, 0xbe, 0x45, 0xd8, 0x81, 0xff, 0x92, 0xc0, 0xc1, 0xa8, 0xfd,
-	0x05, 0xcf, 0x66, 0x58, 0xed, 0x09, 0x9f, 0xaf, 0x78, 0x2e, 0x27, 0x4a, 0x50, 0x7c, 0x87, 0x51,
-	0x5c, 0x83, 0xb7, 0x53, 0x28, 0x1a, 0x0c, 0x9b, 0x93, 0x29, 0xfc, 0x48, 0x02, 0xe3, 0xbe, 0xd7,
-	0x0b, 0xb3, 0xea, 0xef, 0xef, 0xc8, 0xdf, 0xcc, 0x0e, 0xc8, 0x51, 0x77, 0xfe, 0x8c, 0xd9, 0xd9,
-	0x89, 0xfc, 0x91, 0xd7, 0x1d, 0x33, 0xef, 0xb2, 0xd4, 0x5d, 0xd8, 0x77, 0xcc, 0x52, 0x77, 0x11,
-	0x57, 0x10, 0x2d, 0x33, 0x16, 0x8b, 0xf0, 0x5a, 0x0a, 0x0b, 0x66, 0x01, 0x26, 0x48, 0xc4, 0xcc,
-	0xc7, 0x0e, 0xfc, 0xbd, 0x04, 0x0e, 0x44, 0x9c, 0x32, 0x98, 0xba, 0xa6, 0xfb, 0xb8, 0x79, 0xc5,
-	0xb3, 0xf9, 0x40, 0x82, 0xcb, 0x39, 0xc6, 0x45, 0x86, 0xb3, 0xbb, 0x70, 0x09, 0xfe, 0x7b, 0x2c,
-	0xef, 0x68, 0x5c, 0xf0, 0x5f, 0x4b, 0x60, 0xdc, 0xb7, 0x2e, 0x53, 0x2b, 0x27, 0xee, 0x7e, 0xa6,
-	0x56, 0x4e, 0xc2, 0x15, 0x45, 0xb3, 0x2c, 0xcf, 0x53, 0xf0, 0x64, 0xa6, 0x3c, 0xe1, 0x87, 0x12,
-	0x80, 0x8b, 0xc4, 0x89, 0xf9, 0x80, 0x30, 0x6d, 0x15, 0xf6, 0x37, 0x24, 0x8b, 0xaf, 0xe7, 0x85,
-	0x89, 0xa4, 0xcf, 0xb0, 0xa4, 0x67, 0xe1, 0x6b, 0xbb, 0x24, 0x6d, 0xf9, 0x58, 0x95, 0xf9, 0x8c,
-	0xf0, 0x53, 0x09, 0x4c, 0x47, 0x52, 0xf7, 0x7c, 0x3c, 0x78, 0x21, 0x73, 0x1a, 0x31, 0x67, 0xb2,
-	0x78, 0xf1, 0x19, 0x90, 0x82, 0xc3, 0x35, 0xc6, 0xe1, 0x0a, 0x7c, 0x23, 0x1b, 0x07, 0xaf, 0xd8,
-	0x63, 0x65, 0x0f, 0xff, 0xc0, 0xb7, 0x1a, 0xee, 0xf8, 0x65, 0xd9, 0x6a, 0x22, 0xae, 0x64, 0x96,
-	0xad, 0x26, 0x6a, 0x26, 0xa2, 0xb7, 0x58, 0xde, 0x6f, 0xc2, 0xcb, 0x29, 0x8b, 0x94, 0xdb, 0x86,
-	0x89, 0x55, 0x2a, 0xdc, 0xca, 0x0e, 0xfc, 0x3b, 0xdf, 0x5a, 0x58, 0xf4, 0x2c, 0x57, 0x8f, 0xb8,
-	0xe7, 0x98, 0xe5, 0xea, 0x91, 0xf0, 0xf5, 0xd0, 0xbb, 0x2c, 0xfb, 0x7b, 0xf0, 0x6e, 0x96, 0xec,
-	0xd5, 0xf5, 0x6d, 0x55, 0xd7, 0x72, 0x1c, 0x70, 0xba, 0xd6, 0x81, 0x1f, 0x14, 0xc0, 0x64, 0x1f,
-	0x93, 0x0a, 0x5e, 0x4c, 0x4f, 0x77, 0x80, 0x4d, 0x58, 0xbc, 0xf4, 0x2c, 0x50, 0x41, 0xf8, 0xe7,
-	0x12, 0x63, 0xfc, 0x53, 0x09, 0xfe, 0x48, 0x4a, 0xe1, 0xdc, 0xf0, 0x63, 0xe4, 0x3d, 0x27, 0xe4,
-	0x9d, 0xbe, 0x7e, 0x5f, 0x47, 0xde, 0x09, 0x7b, 0x78, 0x1d, 0xf8, 0x3f, 0x09, 0x1c, 0x8e, 0xfb,
-	0x48, 0xf0, 0xf5, 0x74, 0x76, 0xfd, 0xcc, 0xb7, 0xe2, 0xf9, 0xdc, 0x38, 0x21, 0x89, 0xc5, 0x14,
-	0x31, 0xe0, 0xf7, 0x53, 0xf4, 0x68, 0x32, 0xb4, 0x6a, 0x73, 0x78, 0x0e, 0x31, 0x12, 0x2e, 0x5a,
-	0x07, 0xfe, 0x8c, 0xef, 0x9b, 0x31, 0xb3, 0x22, 0x75, 0xdf, 0xec, 0x6f, 0xbc, 0xa4, 0xee, 0x9b,
-	0x03, 0x3c, 0x11, 0x34, 0x04, 0x7f, 0xc8, 0xae, 0x5d, 0x21, 0x33, 0x20, 0xcb, 0xb5, 0x2b, 0x69,
-	0x69, 0x64, 0xb9, 0x76, 0xf5, 0x31, 0x2c, 0xd0, 0x10, 0xfc, 0x01, 0x38, 0x10, 0xf9, 0xd4, 0x85,
-	0x59, 0x97, 0x71, 0xd8, 0x8f, 0x28, 0x9e, 0xcd, 0x07, 0xf2, 0x46, 0xaf, 0x2e, 0x7e, 0xfc, 0xa4,
-	0x24, 0x3d, 0x7e, 0x52, 0x92, 0xfe, 0xfd, 0xa4, 0x24, 0x3d, 0x7a, 0x5a, 0x1a, 0x7a, 0xfc, 0xb4,
-	0x34, 0xf4, 0x8f, 0xa7, 0xa5, 0xa1, 0x7b, 0xb3, 0x21, 0xcb, 0x20, 0x5e, 0x16, 0xb3, 0xbc, 0x2e,
-	0xb6, 0x58, 0x65, 0x30, 0xf7, 0x60, 0x7d, 0x94, 0xbd, 0x3f, 0xf3, 0x59, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf0, 0x0a, 0x3f, 0x2b, 0x03, 0x27, 0x00, 0x00,
+	// 2479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x57, 0x1f, 0xb5, 0xc6, 0x8e, 0x2d, 0x8f, 0x3e, 0x2c, 0x33, 0xae, 0xe8, 0xd2, 0x70,
+	0x9c, 0x26, 0xd5, 0xd2, 0x96, 0xbf, 0x0d, 0xc4, 0x8e, 0x57, 0xb2, 0x55, 0xa3, 0x96, 0x2d, 0x53,
+	0xb6, 0xe2, 0xb8, 0x71, 0x99, 0x11, 0x39, 0x5a, 0xb1, 0xe2, 0x92, 0x6b, 0x92, 0x1b, 0x4b, 0x50,
+	0x85, 0x7e, 0xa1, 0x97, 0xf4, 0x62, 0x20, 0x3d, 0x34, 0x87, 0xfe, 0x01, 0x3d, 0xf4, 0xd0, 0x4b,
+	0x91, 0xf6, 0xde, 0x20, 0x40, 0x8b, 0xd4, 0x40, 0x5a, 0xa0, 0x68, 0x81, 0x45, 0x61, 0xf7, 0xb4,
+	0xf7, 0xa2, 0xe8, 0xad, 0xe0, 0xcc, 0x23, 0x97, 0x4b, 0x72, 0xb5, 0xa4, 0x65, 0x04, 0xf1, 0xc1,
+	0x58, 0xed, 0x9b, 0xf9, 0xbd, 0x79, 0xbf, 0xdf, 0xbc, 0x79, 0x1c, 0xbe, 0x35, 0xda, 0x6f, 0xd0,
+	0x75, 0xe5, 0x61, 0x83, 0xba, 0x1b, 0xe5, 0xba, 0xeb, 0xf8, 0x0e, 0x9e, 0xf0, 0xa8, 0xc9, 0xfe,
+	0xd2, 0x1d, 0xab, 0xec, 0x51, 0x53, 0x5f, 0x25, 0xa6, 0x5d, 0x36, 0xe8, 0xba, 0x38, 0x5a, 0x75,
+	0xaa, 0x0e, 0x1b, 0x52, 0x82, 0xbf, 0xf8, 0x7c, 0xf1, 0x70, 0xd5, 0x71, 0xaa, 0x16, 0x55, 0x48,
+	0xdd, 0x54, 0x88, 0x6d, 0x3b, 0x3e, 0xf1, 0x4d, 0xc7, 0xf6, 0x60, 0xf4, 0x0d, 0xdd, 0xf1, 0x6a,
+	0x8e, 0xa7, 0x2c, 0x13, 0x8f, 0xf2, 0x65, 0x94, 0x0f, 0x4e, 0x2e, 0x53, 0x9f, 0x9c, 0x54, 0xea,
+	0xa4, 0x6a, 0xda, 0x6c, 0x32, 0xcc, 0x1d, 0x0e, 0x42, 0xa9, 0x13, 0x97, 0xd4, 0x42, 0xf4, 0x48,
+	0x60, 0xb1, 0x1c, 0xbb, 0xaa, 0x2d, 0x3b, 0xce, 0x1a, 0x18, 0x47, 0x03, 0xa3, 0xb7, 0xea, 0xb8,
+	0x7e, 0xdc, 0xca, 0x78, 0xd4, 0x5d, 0x53, 0xa7, 0x60, 0xc0, 0x81, 0x41, 0x77, 0x6c, 0xdf, 0x25,
+	0xba, 0x0f, 0xb6, 0x7d, 0x81, 0xcd, 0x7f, 0x44, 0xea, 0x71, 0x57, 0xc4, 0xf3, 0xa8, 0xaf, 0x59,
+	0xa6, 0xd7, 0x31, 0xab, 0x4e, 0x4c, 0x37, 0xee, 0xda, 0x71, 0x0d, 0x1a, 0x1a, 0xc6, 0x03, 0x43,
+	0x8d, 0xf8, 0xfa, 0xaa, 0xe6, 0x52, 0xaf, 0x61, 0xf9, 0xf1, 0x89, 0xd4, 0x6e, 0x84, 0xf1, 0xcb,
+	0xa3, 0x08, 0xdf, 0x0e, 0x38, 0x2f, 0x30, 0x52, 0x2a, 0x7d, 0xd8, 0xa0, 0x9e, 0x2f, 0xdf, 0x45,
+	0x23, 0x1d, 0x56, 0xaf, 0xee, 0xd8, 0x1e, 0xc5, 0x97, 0xd0, 0x20, 0x27, 0x3f, 0x21, 0x1c, 0x11,
+	0x5e, 0xdf, 0x33, 0x7d, 0xa4, 0xdc, 0x6d, 0x27, 0xca, 0x1c, 0x59, 0xe9, 0xff, 0xac, 0x29, 0xed,
+	0x52, 0x01, 0x25, 0x7f, 0x24, 0xa0, 0x83, 0xcc, 0xef, 0x1c, 0xf5, 0x6f, 0x38, 0x76, 0xb5, 0xe2,
+	0x38, 0x6b, 0xb0, 0x24, 0x1e, 0x45, 0x03, 0x4c, 0x1b, 0xe6, 0x7a, 0x48, 0xe5, 0x5f, 0xb0, 0x8c,
+	0xf6, 0x86, 0x02, 0x5d, 0x31, 0x0c, 0x77, 0xa2, 0xc4, 0x06, 0x3b, 0x6c, 0x78, 0x12, 0x21, 0x36,
+	0x79, 0x96, 0xda, 0x4e, 0x6d, 0xa2, 0x8f, 0xcd, 0x88, 0x59, 0x82, 0x71, 0x26, 0x20, 0x1f, 0xef,
+	0xe7, 0xe3, 0x6d, 0x8b, 0xfc, 0x3e, 0x9a, 0x48, 0x07, 0x05, 0x8c, 0x67, 0xd1, 0xee, 0xd0, 0x06,
+	0x9c, 0xe5, 0xee, 0x9c, 0xc3, 0x99, 0xc0, 0x3a, 0x42, 0xca, 0x7f, 0x0c, 0x79, 0x5f, 0xb1, 0xac,
+	0x24, 0xef, 0x6b, 0x08, 0xb5, 0xd3, 0x0c, 0xd6, 0x78, 0xad, 0xcc, 0x73, 0xb2, 0x1c, 0xe4, 0x64,
+	0x99, 0xa7, 0x3e, 0xe4, 0x64, 0x79, 0x81, 0x54, 0x29, 0x60, 0xd5, 0x18, 0xf2, 0x4b, 0x51, 0xea,
+	0xd7, 0x02, 0x9a, 0x48, 0xf3, 0xc8, 0x94, 0xaa, 0xef, 0xf9, 0xa4, 0xc2, 0x73, 0x1d, 0x72, 0x94,
+	0x98, 0x1c, 0xc7, 0x7b, 0xca, 0xc1, 0x43, 0x88, 0xeb, 0x21, 0xff, 0x42, 0x68, 0x6f, 0xeb, 0x62,
+	0x70, 0x14, 0xbf, 0x1a, 0xc9, 0x66, 0xa0, 0x43, 0x19, 0x51, 0x81, 0x84, 0x73, 0x68, 0x28, 0x32,
+	0x42, 0x2a, 0x1c, 0xed, 0xae, 0x61, 0x34, 0x15, 0x44, 0x6c, 0x63, 0xe5, 0x4f, 0x63, 0x1b, 0x95,
+	0x22, 0xff, 0x32, 0x65, 0xdc, 0x6f, 0x04, 0x74, 0x28, 0x83, 0x48, 0xb6, 0x5e, 0x7d, 0xcf, 0xab,
+	0xd7, 0x8b, 0xcb, 0xba, 0x4d, 0x34, 0x16, 0x6e, 0xef, 0x42, 0xc0, 0x32, 0xac, 0xa8, 0x09, 0x21,
+	0x84, 0x1e, 0x42, 0x94, 0x92, 0x42, 0xa4, 0xc4, 0xee, 0x4b, 0x8b, 0x2d, 0xdf, 0x46, 0xe3, 0xc9,
+	0xc5, 0x41, 0xa8, 0x73, 0x68, 0x90, 0xad, 0xe5, 0x81, 0x4a, 0xd2, 0x36, 0x85, 0x3b, 0x98, 0xa7,
+	0xc2, 0x74, 0xf9, 0x97, 0x02, 0x1a, 0xed, 0xf0, 0xf9, 0x25, 0xf2, 0xc1, 0x87, 0xd1, 0x90, 0x6f,
+	0xd6, 0xa8, 0xe7, 0x93, 0x5a, 0x9d, 0xe5, 0x46, 0xbf, 0xda, 0x36, 0xc8, 0x46, 0x42, 0xea, 0x88,
+	0xec, 0x99, 0xf8, 0xe1, 0xce, 0xc1, 0x15, 0x4e, 0xff, 0x28, 0x1a, 0x58, 0x71, 0x1a, 0xb6, 0xc1,
+	0x82, 0xdd, 0xad, 0xf2, 0x2f, 0xf2, 0x27, 0x02, 0x12, 0xa3, 0xa7, 0x03, 0xf1, 0xa9, 0xd7, 0x29,
+	0x83, 0x92, 0x96, 0xa1, 0xb2, 0xbf, 0xd5, 0x94, 0xf6, 0x30, 0xab, 0x66, 0x04, 0xe6, 0x0e, 0x5d,
+	0x94, 0xb4, 0x2e, 0x1c, 0xc0, 0x9f, 0xf1, 0x00, 0x88, 0x09, 0x75, 0x3e, 0x4b, 0xa8, 0xca, 0x68,
+	0xab, 0x29, 0x0d, 0x87, 0x76, 0x8d, 0x18, 0x86, 0x4b, 0x3d, 0x2f, 0x91, 0x0e, 0x77, 0xd0, 0xab,
+	0x99, 0x91, 0xef, 0x48, 0x26, 0xf9, 0x71, 0x2c, 0x23, 0xee, 0x3c, 0x22, 0xf5, 0x28, 0xc3, 0x93,
+	0x81, 0x0a, 0x79, 0x03, 0xc5, 0x97, 0xd0, 0x7e, 0xcb, 0x71, 0xd6, 0x96, 0x89, 0xbe, 0xb6, 0x48,
+	0x75, 0xc7, 0x36, 0x3c, 0x26, 0x4c, 0x3f, 0x07, 0x87, 0x43, 0x9a, 0xc7, 0xc7, 0xd4, 0xe4, 0x64,
+	0xf9, 0x1e, 0x1a, 0x4b, 0x44, 0x04, 0x14, 0x2f, 0xa3, 0x81, 0xe0, 0x2a, 0x15, 0x66, 0xfd, 0x64,
+	0x77, 0x8a, 0x01, 0xae, 0x32, 0xd4, 0x6a, 0x4a, 0x1c, 0xa0, 0xf2, 0x0f, 0xf9, 0x20, 0x78, 0xbe,
+	0x12, 0xec, 0xc7, 0x0d, 0xd3, 0xf3, 0xc3, 0x0b, 0x12, 0x45, 0xe3, 0xc9, 0x01, 0x58, 0xf3, 0x3b,
+	0x68, 0x88, 0x84, 0x46, 0x58, 0xf7, 0x78, 0xf7, 0x75, 0x19, 0x7e, 0x9e, 0xfa, 0xc4, 0x20, 0x3e,
+	0x09, 0xeb, 0x52, 0x84, 0x97, 0x4f, 0x86, 0xd5, 0x2f, 0x3e, 0x2d, 0xf6, 0x10, 0x33, 0x62, 0xa7,
+	0x8f, 0x7f, 0x91, 0x09, 0x12, 0xb3, 0x20, 0x10, 0xdd, 0x0c, 0xda, 0x5d, 0x03, 0x1b, 0xec, 0x7b,
+	0xde, 0xe0, 0xd4, 0x08, 0x28, 0xbf, 0x03, 0x89, 0xa5, 0xd2, 0xaa, 0xe9, 0xf9, 0xd4, 0xa5, 0xc6,
+	0x02, 0x31, 0xdd, 0x9d, 0x27, 0x82, 0x7c, 0x1f, 0x1d, 0xce, 0x76, 0x0c, 0xd1, 0x5f, 0x44, 0x03,
+	0xc1, 0xa5, 0x37, 0xc7, 0x7e, 0x06, 0x38, 0x90, 0x93, 0x43, 0xe4, 0xfb, 0x68, 0x32, 0xe1, 0x7b,
+	0x06, 0x96, 0xde, 0x79, 0xdc, 0x75, 0x24, 0x75, 0xf5, 0x0d, 0xa1, 0xcf, 0xa3, 0x57, 0x22, 0x27,
+	0xa6, 0xbd, 0xe2, 0x80, 0xfa, 0xaf, 0x77, 0xa7, 0x10, 0xba, 0xb8, 0x6e, 0xaf, 0x38, 0x4b, 0xd3,
+	0xed, 0x15, 0x83, 0xef, 0xf2, 0xef, 0x85, 0xf6, 0xe1, 0x9e, 0x27, 0xee, 0x1a, 0xf5, 0x3b, 0x2e,
+	0xf0, 0x3b, 0x38, 0x8c, 0x9d, 0x15, 0xad, 0x54, 0xb4, 0xa2, 0xf5, 0xf5, 0xac, 0x68, 0x72, 0xab,
+	0x0f, 0x1d, 0xce, 0x8e, 0x1d, 0xb4, 0x3a, 0x8f, 0xfa, 0x83, 0x3d, 0x03, 0x89, 0xf2, 0xed, 0x32,
+	0x43, 0xe0, 0x6b, 0x68, 0xa4, 0x46, 0xd6, 0x35, 0xf6, 0x26, 0xa4, 0xd5, 0x83, 0x7f, 0xac, 0xc2,
+	0xf1, 0x6a, 0x72, 0xb0, 0xd5, 0x94, 0xb2, 0x86, 0xd5, 0xe1, 0x1a, 0x59, 0xbf, 0x15, 0xd8, 0x16,
+	0xa8, 0xcb, 0x6a, 0x1d, 0xbe, 0x81, 0xc6, 0x0c, 0xba, 0x42, 0x1a, 0x96, 0xaf, 0x55, 0x89, 0xc7,
+	0xa6, 0x32, 0x10, 0xa3, 0xd7, 0x5f, 0x39, 0xd4, 0x6a, 0x4a, 0xd9, 0x13, 0x54, 0x0c, 0xe6, 0x39,
+	0xe2, 0x2d, 0x50, 0x97, 0x79, 0xc5, 0xb7, 0xd0, 0x78, 0x72, 0xb2, 0x4e, 0x6c, 0x9d, 0x5a, 0xfc,
+	0xa1, 0x56, 0x11, 0x5b, 0x4d, 0xa9, 0xcb, 0x0c, 0x75, 0xa4, 0xc3, 0xdf, 0x0c, 0x33, 0xe2, 0x55,
+	0x24, 0x65, 0xae, 0xae, 0x05, 0xc7, 0x53, 0x5b, 0xde, 0xf0, 0xe9, 0xc4, 0x00, 0xf3, 0x7c, 0xb4,
+	0xd5, 0x94, 0x7a, 0x4d, 0x55, 0xc5, 0x74, 0xc8, 0xb3, 0x41, 0x35, 0xda, 0xf0, 0x83, 0x0a, 0x3a,
+	0x6c, 0x37, 0x6a, 0x9a, 0x41, 0xeb, 0xd4, 0x36, 0xa8, 0xad, 0x9b, 0xd4, 0x9b, 0x18, 0x6c, 0xd7,
+	0xe6, 0xe4, 0x98, 0xba, 0xdf, 0x6e, 0xd4, 0x66, 0x63, 0x06, 0x79, 0xbd, 0x5d, 0x9b, 0x99, 0xe7,
+	0x17, 0x90, 0xa1, 0xc7, 0xd0, 0xd7, 0x88, 0xae, 0x3b, 0x0d, 0xdb, 0x87, 0xf4, 0xdc, 0xd3, 0x6a,
+	0x4a, 0xa1, 0x49, 0x0d, 0xff, 0x90, 0x1f, 0xa0, 0xf1, 0xe4, 0xca, 0x51, 0x11, 0x1c, 0x64, 0x1a,
+	0xe4, 0xb8, 0x0d, 0x31, 0x64, 0x05, 0xb5, 0x9a, 0x12, 0x40, 0x54, 0xf8, 0x94, 0x3f, 0x8f, 0xbd,
+	0x5f, 0xf0, 0x59, 0x1b, 0xd7, 0x67, 0x5f, 0x82, 0xe3, 0x87, 0xc7, 0x51, 0xc9, 0x34, 0x20, 0xf3,
+	0x06, 0x5b, 0x4d, 0xa9, 0x64, 0x1a, 0x6a, 0xc9, 0x34, 0xe4, 0x07, 0xe8, 0x50, 0x06, 0x1f, 0x90,
+	0xec, 0x6d, 0x34, 0xc0, 0x0f, 0x40, 0xcf, 0xcb, 0x02, 0xc7, 0xb2, 0x47, 0x29, 0x3f, 0x11, 0xfc,
+	0x43, 0xfe, 0x73, 0x09, 0x8a, 0xe4, 0x1c, 0xf5, 0xbf, 0x6d, 0x7a, 0xbe, 0xe3, 0x9a, 0x3a, 0xb1,
+	0x3a, 0x2f, 0xc9, 0x5f, 0x65, 0xd9, 0x54, 0x34, 0x56, 0xa7, 0xae, 0xe9, 0x18, 0x37, 0xa8, 0x5d,
+	0xf5, 0x57, 0xaf, 0xdb, 0xe1, 0x55, 0x85, 0x2b, 0x79, 0xb8, 0xd5, 0x94, 0x26, 0xf8, 0x04, 0xcd,
+	0x62, 0x33, 0x34, 0xd3, 0x8e, 0xae, 0x2c, 0xd9, 0x50, 0x7c, 0x01, 0xed, 0xb5, 0x1b, 0xb5, 0x5b,
+	0x2b, 0x0b, 0x6c, 0xd4, 0x83, 0x43, 0x3b, 0xd6, 0x6a, 0x4a, 0x07, 0xec, 0x46, 0x6d, 0x39, 0x38,
+	0xab, 0x2b, 0x1a, 0x87, 0x7a, 0x6a, 0xc7, 0x54, 0xd9, 0x45, 0x47, 0xba, 0xab, 0x09, 0x9b, 0x76,
+	0x33, 0x71, 0xeb, 0x7f, 0xa3, 0xc7, 0x15, 0x6f, 0x86, 0xd8, 0x86, 0x45, 0x3d, 0xdf, 0xd4, 0xd7,
+	0x78, 0xca, 0x73, 0x74, 0xf4, 0x32, 0xf0, 0xe3, 0x52, 0xb2, 0x70, 0x2f, 0x36, 0x6a, 0x35, 0xe2,
+	0x6e, 0xbc, 0x0c, 0xfb, 0x77, 0x15, 0x1d, 0x08, 0xef, 0x8d, 0xc9, 0xbd, 0x63, 0x0f, 0x86, 0x70,
+	0x30, 0xbe, 0x6d, 0x69, 0x84, 0xfc, 0xbf, 0x3e, 0xf4, 0xf5, 0x2e, 0x1a, 0x80, 0xea, 0xef, 0xa1,
+	0x3d, 0xbe, 0xe3, 0x13, 0x6b, 0xc9, 0xb1, 0x1a, 0x35, 0xe8, 0x30, 0x54, 0x2e, 0xfe, 0xa3, 0x29,
+	0xbd, 0x56, 0x35, 0xfd, 0xd5, 0xc6, 0x72, 0x59, 0x77, 0x6a, 0x0a, 0xf4, 0x1c, 0xf9, 0xc7, 0x94,
+	0x67, 0xac, 0x29, 0xfe, 0x46, 0x9d, 0x7a, 0xe5, 0x59, 0xaa, 0xb7, 0x9a, 0xd2, 0x5e, 0xe6, 0x40,
+	0xfb, 0x80, 0x79, 0x50, 0xe3, 0xee, 0x70, 0x03, 0x8d, 0xc4, 0xbe, 0xde, 0x74, 0x82, 0xb7, 0x4e,
+	0x62, 0x81, 0x62, 0x33, 0x85, 0x56, 0x19, 0x8b, 0xaf, 0xa2, 0xd9, 0xe0, 0x4a, 0xcd, 0xf2, 0x8f,
+	0x97, 0xd0, 0xd0, 0xaa, 0x59, 0x5d, 0x65, 0x69, 0x02, 0x6a, 0x9f, 0x2f, 0xb4, 0x18, 0x0a, 0xe0,
+	0xf0, 0xbc, 0x6d, 0xbb, 0xc2, 0x8b, 0x68, 0xb7, 0xe5, 0x3c, 0xe2, 0x6e, 0xd9, 0xdb, 0x7f, 0xe5,
+	0x5c, 0x21, 0xb7, 0x43, 0x96, 0xf3, 0x08, 0xbc, 0x46, 0x8e, 0x82, 0x60, 0x2d, 0x02, 0xaf, 0x3b,
+	0x13, 0x03, 0xcf, 0x13, 0x6c, 0x00, 0x0f, 0x83, 0x8d, 0x5c, 0xc9, 0x1f, 0x87, 0x97, 0x2e, 0x56,
+	0xe3, 0x16, 0xcd, 0x5a, 0xc3, 0x62, 0x6f, 0xfd, 0x61, 0xfa, 0xef, 0xb8, 0x48, 0xa6, 0x0e, 0x50,
+	0x29, 0xf7, 0x15, 0xf4, 0xe7, 0x02, 0x9c, 0xcd, 0x54, 0x6c, 0x90, 0x96, 0x6b, 0x68, 0xf8, 0xea,
+	0x3a, 0xd5, 0x1b, 0x3e, 0x35, 0x6e, 0x37, 0x88, 0xed, 0x9b, 0xfe, 0x06, 0xe4, 0xe6, 0xe5, 0x42,
+	0xda, 0x1c, 0xa0, 0xe0, 0x45, 0x7b, 0x08, 0x6e, 0xd4, 0x94, 0x63, 0x79, 0xa9, 0xfd, 0xd2, 0x3c,
+	0x1f, 0x34, 0xa1, 0x55, 0xd6, 0x83, 0xde, 0xf9, 0x45, 0x7b, 0x15, 0xbd, 0x9a, 0xe9, 0x17, 0x38,
+	0x5e, 0x47, 0x83, 0xbc, 0xdb, 0x0d, 0x3b, 0x70, 0xac, 0xfb, 0x0e, 0xc4, 0xe0, 0xbc, 0xd6, 0x71,
+	0xa0, 0x0a, 0x9f, 0xf2, 0x7f, 0x4a, 0x89, 0xc7, 0xe1, 0x0c, 0xbb, 0x5d, 0xbc, 0x04, 0x85, 0xee,
+	0x7a, 0xf8, 0x5e, 0xcf, 0xcf, 0xd3, 0xa9, 0x42, 0xbb, 0x3b, 0x50, 0x8f, 0xbd, 0xeb, 0xe3, 0x87,
+	0xe8, 0x40, 0xdd, 0xf1, 0xcc, 0x20, 0x8f, 0x66, 0x4d, 0x97, 0xea, 0xc1, 0x1f, 0xec, 0x40, 0xed,
+	0x9b, 0x7e, 0x73, 0x9b, 0x67, 0x49, 0x12, 0x52, 0x19, 0x6f, 0x35, 0x25, 0x1c, 0x7a, 0xd2, 0x8c,
+	0xd0, 0xae, 0xa6, 0xbd, 0xcb, 0x6f, 0x21, 0x31, 0x4b, 0x76, 0xd8, 0x60, 0x09, 0x0d, 0xf0, 0x8b,
+	0x9f, 0xc0, 0x0a, 0x37, 0x3b, 0x40, 0xcc, 0xa0, 0xf2, 0x8f, 0xe9, 0x0f, 0x8f, 0xa0, 0x01, 0x86,
+	0xc7, 0x8f, 0x05, 0x34, 0xc8, 0xdf, 0x2b, 0xf0, 0xb7, 0xba, 0xc7, 0x9a, 0xfe, 0xed, 0x43, 0x9c,
+	0xca, 0x39, 0x9b, 0x87, 0x24, 0x7f, 0xf3, 0x27, 0x5f, 0xfc, 0xfb, 0xa3, 0xd2, 0x51, 0xfc, 0x0d,
+	0xc5, 0xa3, 0xe6, 0x54, 0x88, 0x53, 0x42, 0x9c, 0xd2, 0xfe, 0xc5, 0x08, 0x3f, 0x11, 0xda, 0x2d,
+	0x72, 0x7c, 0xb2, 0xc7, 0x32, 0xe9, 0x9f, 0x48, 0xc4, 0xe9, 0x22, 0x10, 0x08, 0xef, 0x01, 0x0b,
+	0xef, 0x1d, 0x7c, 0x77, 0x9b, 0xf0, 0xa2, 0x9f, 0xaf, 0x94, 0xcd, 0x78, 0xae, 0x6e, 0x29, 0x9b,
+	0xed, 0x3c, 0xdc, 0x52, 0x36, 0xdb, 0x39, 0x16, 0x8e, 0x6c, 0xe1, 0x3f, 0x09, 0x68, 0x4f, 0xb8,
+	0xe6, 0x15, 0xcb, 0xea, 0xc9, 0x2a, 0xfd, 0x03, 0x88, 0x38, 0x5d, 0x04, 0x02, 0xac, 0xee, 0x32,
+	0x56, 0xb7, 0xf0, 0xfc, 0x0b, 0x65, 0x85, 0xff, 0x2a, 0xc4, 0x1a, 0xca, 0x38, 0x87, 0xdc, 0xc9,
+	0xde, 0xba, 0x78, 0xaa, 0x10, 0x06, 0xd8, 0x7c, 0x8f, 0xb1, 0xb9, 0x87, 0x97, 0xb6, 0x61, 0xd3,
+	0xfe, 0x35, 0xb1, 0xf8, 0x26, 0xfd, 0x45, 0x40, 0x7b, 0xa3, 0x55, 0x83, 0x5d, 0xca, 0x21, 0x79,
+	0x61, 0x66, 0x59, 0x0d, 0x7a, 0x79, 0x89, 0x31, 0x5b, 0xc0, 0x37, 0x5f, 0x2c, 0x33, 0xfc, 0xb9,
+	0x80, 0x76, 0x87, 0x7d, 0x5f, 0x5c, 0xee, 0xad, 0x79, 0xbc, 0x67, 0x2b, 0x2a, 0xb9, 0xe7, 0x03,
+	0x0b, 0xc2, 0x58, 0x7c, 0x17, 0xbf, 0xbb, 0x0d, 0x8b, 0x2a, 0x85, 0x0b, 0x43, 0x81, 0xed, 0x89,
+	0x7a, 0xd9, 0x5b, 0xf8, 0x9f, 0x02, 0xda, 0xd7, 0xd9, 0xa7, 0xc5, 0xa7, 0x73, 0x9c, 0xf6, 0x54,
+	0x43, 0x5a, 0x3c, 0x53, 0x10, 0x05, 0x14, 0xdf, 0x63, 0x14, 0x97, 0xf0, 0x9d, 0x1e, 0x14, 0x2d,
+	0x86, 0x2d, 0xc8, 0x14, 0x7f, 0x2a, 0xa0, 0xa1, 0x50, 0x55, 0x0f, 0xe7, 0xd5, 0x3f, 0xaa, 0xc8,
+	0x27, 0xf2, 0x03, 0x0a, 0xe4, 0x5d, 0xb4, 0x63, 0x5e, 0x7e, 0x22, 0x7f, 0xe0, 0x79, 0xc7, 0xba,
+	0xcc, 0x79, 0xf2, 0x2e, 0xde, 0x20, 0x17, 0x95, 0xdc, 0xf3, 0x81, 0xc5, 0x3c, 0x63, 0x31, 0x87,
+	0xaf, 0xf6, 0x60, 0xc1, 0x7a, 0xd5, 0x29, 0x12, 0x89, 0x2e, 0xf9, 0x16, 0xfe, 0xad, 0x80, 0x5e,
+	0xe9, 0x68, 0xe9, 0xe2, 0x9e, 0x67, 0x3a, 0xa3, 0xed, 0x2c, 0x9e, 0x2e, 0x06, 0x02, 0x2e, 0x67,
+	0x18, 0x17, 0x05, 0x4f, 0x6d, 0xc3, 0xa5, 0xfd, 0xdf, 0x1c, 0x94, 0x4d, 0x83, 0x0b, 0xfe, 0x2b,
+	0x01, 0x0d, 0x45, 0x3d, 0xf6, 0x9e, 0x99, 0x93, 0x6c, 0xd3, 0x8b, 0x27, 0xf2, 0x03, 0x20, 0xce,
+	0x29, 0x16, 0xe7, 0x71, 0x7c, 0x2c, 0x57, 0x9c, 0xf8, 0x13, 0x01, 0xe1, 0x39, 0xea, 0x27, 0x1a,
+	0xd6, 0xb8, 0xd7, 0x29, 0xcc, 0xee, 0x9c, 0x8b, 0x67, 0x8b, 0xc2, 0x20, 0xe8, 0x53, 0x2c, 0xe8,
+	0x29, 0xfc, 0xe6, 0x36, 0x41, 0xbb, 0x11, 0x56, 0x63, 0x0d, 0x71, 0xfc, 0x85, 0x80, 0xc6, 0x3a,
+	0x42, 0x0f, 0x1b, 0xce, 0xf8, 0x7c, 0xee, 0x30, 0x12, 0x2d, 0x74, 0xf1, 0xc2, 0x73, 0x20, 0x81,
+	0xc3, 0x55, 0xc6, 0xe1, 0x32, 0x7e, 0x2b, 0x1f, 0x87, 0x30, 0xd9, 0x13, 0x69, 0x1f, 0x14, 0xd2,
+	0xfd, 0x89, 0xbe, 0x32, 0xce, 0x51, 0x13, 0x33, 0x7a, 0xe8, 0xe2, 0xd9, 0xa2, 0x30, 0x60, 0xf2,
+	0x2e, 0x63, 0xb2, 0x88, 0x6f, 0x6f, 0xc3, 0xa4, 0xc6, 0x80, 0x1a, 0xbf, 0x18, 0xe6, 0xaf, 0x3f,
+	0xbf, 0xe3, 0x85, 0x94, 0xf7, 0x33, 0xf3, 0x14, 0xd2, 0x8e, 0x9e, 0xab, 0x78, 0x22, 0x3f, 0x00,
+	0xb8, 0x5c, 0x63, 0x5c, 0xde, 0xc6, 0x97, 0x7a, 0x94, 0x20, 0xde, 0x14, 0x4d, 0x11, 0x81, 0x5e,
+	0xec, 0x16, 0xfe, 0x1b, 0x2f, 0x9c, 0xcc, 0x7b, 0x9e, 0x8b, 0x55, 0xb2, 0xa3, 0x2a, 0x9e, 0x2a,
+	0x84, 0x81, 0xe8, 0xdf, 0x67, 0xd1, 0xdf, 0xc7, 0xf7, 0xf2, 0x44, 0xaf, 0x2d, 0x6f, 0x68, 0xa6,
+	0x51, 0xe0, 0xf1, 0x6d, 0x1a, 0x5b, 0xf8, 0xe3, 0x12, 0x1a, 0xc9, 0x68, 0xc1, 0xe1, 0x0b, 0xbd,
+	0xc3, 0xed, 0xd2, 0x04, 0x15, 0x2f, 0x3e, 0x0f, 0x14, 0x08, 0x7f, 0x28, 0x30, 0xc6, 0x3f, 0x15,
+	0xf0, 0x8f, 0x84, 0x1e, 0x9c, 0x57, 0x23, 0x1f, 0x45, 0x9f, 0x82, 0xca, 0x66, 0x66, 0x37, 0x73,
+	0x4b, 0xd9, 0x8c, 0x77, 0x28, 0xb7, 0xf0, 0x7f, 0x05, 0x34, 0x9c, 0xec, 0x92, 0xe1, 0xdc, 0x87,
+	0xaa, 0xb3, 0xb5, 0x28, 0x9e, 0x2b, 0x8c, 0x03, 0x49, 0x5c, 0xa6, 0x88, 0x85, 0xbf, 0xdf, 0x43,
+	0x0f, 0x38, 0x91, 0x1e, 0x87, 0x17, 0x10, 0x23, 0xd5, 0x23, 0xdc, 0xc2, 0x3f, 0xe3, 0x4f, 0x85,
+	0x44, 0x2b, 0xa6, 0x67, 0x1d, 0xca, 0x6e, 0x2b, 0x89, 0x67, 0x8b, 0xc2, 0x80, 0xf9, 0x2e, 0xfc,
+	0x43, 0x76, 0xa9, 0x8c, 0xb5, 0x3a, 0xf2, 0x5c, 0x2a, 0xd3, 0x0d, 0x1b, 0xf1, 0x4c, 0x41, 0x54,
+	0x14, 0xc0, 0x0f, 0xd0, 0x2b, 0x1d, 0x2f, 0xf2, 0x38, 0xef, 0x31, 0x8e, 0x77, 0x5b, 0xc4, 0xd3,
+	0xc5, 0x40, 0xe1, 0xea, 0x95, 0xb9, 0xcf, 0x9e, 0x4e, 0x0a, 0x4f, 0x9e, 0x4e, 0x0a, 0xff, 0x7a,
+	0x3a, 0x29, 0x3c, 0x7e, 0x36, 0xb9, 0xeb, 0xc9, 0xb3, 0xc9, 0x5d, 0x7f, 0x7f, 0x36, 0xb9, 0xeb,
+	0xfe, 0x54, 0xac, 0x21, 0x92, 0x4c, 0x8b, 0x29, 0x9e, 0x17, 0xeb, 0x2c, 0x33, 0x58, 0x6f, 0x64,
+	0x79, 0x90, 0x8d, 0x9f, 0xfa, 0xff, 0x00, 0xcc, 0xcf, 0x84, 0xc3, 0x8a, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2299,6 +2462,8 @@ type QueryClient interface {
 	GetRegisteredPairs(ctx context.Context, in *QueryRegisteredPairsRequest, opts ...grpc.CallOption) (*QueryRegisteredPairsResponse, error)
 	// Returns registered contract information
 	GetRegisteredContract(ctx context.Context, in *QueryRegisteredContractRequest, opts ...grpc.CallOption) (*QueryRegisteredContractResponse, error)
+	// Returns the tick sizes and order limits that apply to a registered market
+	GetMarketParams(ctx context.Context, in *QueryGetMarketParamsRequest, opts ...grpc.CallOption) (*QueryGetMarketParamsResponse, error)
 	GetOrders(ctx context.Context, in *QueryGetOrdersRequest, opts ...grpc.CallOption) (*QueryGetOrdersResponse, error)
 	GetOrder(ctx context.Context, in *QueryGetOrderByIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByIDResponse, error)
 	GetHistoricalPrices(ctx context.Context, in *QueryGetHistoricalPricesRequest, opts ...grpc.CallOption) (*QueryGetHistoricalPricesResponse, error)
@@ -2433,6 +2598,15 @@ func (c *queryClient) GetRegisteredContract(ctx context.Context, in *QueryRegist
 	return out, nil
 }
 
+func (c *queryClient) GetMarketParams(ctx context.Context, in *QueryGetMarketParamsRequest, opts ...grpc.CallOption) (*QueryGetMarketParamsResponse, error) {
+	out := new(QueryGetMarketParamsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/GetMarketParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetOrders(ctx context.Context, in *QueryGetOrdersRequest, opts ...grpc.CallOption) (*QueryGetOrdersResponse, error) {
 	out := new(QueryGetOrdersResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Query/GetOrders", in, out, opts...)
@@ -2520,6 +2694,8 @@ type QueryServer interface {
 	GetRegisteredPairs(context.Context, *QueryRegisteredPairsRequest) (*QueryRegisteredPairsResponse, error)
 	// Returns registered contract information
 	GetRegisteredContract(context.Context, *QueryRegisteredContractRequest) (*QueryRegisteredContractResponse, error)
+	// Returns the tick sizes and order limits that apply to a registered market
+	GetMarketParams(context.Context, *QueryGetMarketParamsRequest) (*QueryGetMarketParamsResponse, error)
 	GetOrders(context.Context, *QueryGetOrdersRequest) (*QueryGetOrdersResponse, error)
 	GetOrder(context.Context, *QueryGetOrderByIDRequest) (*QueryGetOrderByIDResponse, error)
 	GetHistoricalPrices(context.Context, *QueryGetHistoricalPricesRequest) (*QueryGetHistoricalPricesResponse, error)
@@ -2572,6 +2748,9 @@ func (*UnimplementedQueryServer) GetRegisteredPairs(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) GetRegisteredContract(ctx context.Context, req *QueryRegisteredContractRequest) (*QueryRegisteredContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRegisteredContract not implemented")
 }
+func (*UnimplementedQueryServer) GetMarketParams(ctx context.Context, req *QueryGetMarketParamsRequest) (*QueryGetMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketParams not implemented")
+}
 func (*UnimplementedQueryServer) GetOrders(ctx context.Context, req *QueryGetOrdersRequest) (*QueryGetOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
@@ -2832,6 +3011,24 @@ func _Query_GetRegisteredContract_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Query/GetMarketParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketParams(ctx, req.(*QueryGetMarketParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetOrdersRequest)
 	if err := dec(in); err != nil {
@@ -3014,6 +3211,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRegisteredContract",
 			Handler:    _Query_GetRegisteredContract_Handler,
 		},
+		{
+			MethodName: "GetMarketParams",
+			Handler:    _Query_GetMarketParams_Handler,
+		},
 		{
 			MethodName: "GetOrders",
 			Handler:    _Query_GetOrders_Handler,
@@ -4064,6 +4265,108 @@ func (m *QueryRegisteredContractResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetMarketParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumDependencies != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumDependencies))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefaultGasPerOrderDataByte != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DefaultGasPerOrderDataByte))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DefaultGasPerCancel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DefaultGasPerCancel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DefaultGasPerOrder != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DefaultGasPerOrder))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOrderPerPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxOrderPerPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5112,7 +5415,7 @@ func (m *QueryRegisteredContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetOrdersRequest) Size() (n int) {
+func (m *QueryGetMarketParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5122,29 +5425,44 @@ func (m *QueryGetOrdersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Account)
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetDenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetOrdersResponse) Size() (n int) {
+func (m *QueryGetMarketParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxOrderPerPrice != 0 {
+		n += 1 + sovQuery(uint64(m.MaxOrderPerPrice))
+	}
+	if m.DefaultGasPerOrder != 0 {
+		n += 1 + sovQuery(uint64(m.DefaultGasPerOrder))
+	}
+	if m.DefaultGasPerCancel != 0 {
+		n += 1 + sovQuery(uint64(m.DefaultGasPerCancel))
+	}
+	if m.DefaultGasPerOrderDataByte != 0 {
+		n += 1 + sovQuery(uint64(m.DefaultGasPerOrderDataByte))
+	}
+	if m.NumDependencies != 0 {
+		n += 1 + sovQuery(uint64(m.NumDependencies))
 	}
 	return n
 }
 
-func (m *QueryGetOrderByIDRequest) Size() (n int) {
+func (m *QueryGetOrdersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5154,28 +5472,60 @@ func (m *QueryGetOrderByIDRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.PriceDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetDenom)
+	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
 	return n
 }
 
-func (m *QueryGetOrderByIDResponse) Size() (n int) {
+func (m *QueryGetOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOrderByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetOrderByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -8191,6 +8541,330 @@ func (m *QueryRegisteredContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetMarketParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderPerPrice", wireType)
+			}
+			m.MaxOrderPerPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderPerPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultGasPerOrder", wireType)
+			}
+			m.DefaultGasPerOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultGasPerOrder |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultGasPerCancel", wireType)
+			}
+			m.DefaultGasPerCancel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultGasPerCancel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultGasPerOrderDataByte", wireType)
+			}
+			m.DefaultGasPerOrderDataByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultGasPerOrderDataByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDependencies", wireType)
+			}
+			m.NumDependencies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDependencies |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
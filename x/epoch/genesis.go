@@ -15,6 +15,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		ctx,
 		*genState.Epoch,
 	)
+	for _, usage := range genState.EpochUsages {
+		k.SetEpochUsage(ctx, usage)
+	}
+	k.SetCurrentEpochUsage(ctx, genState.CurrentEpochUsage)
+	for _, sender := range genState.CurrentEpochSenders {
+		k.SetCurrentEpochSender(ctx, sdk.MustAccAddressFromBech32(sender))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -23,6 +30,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	epoch := k.GetEpoch(ctx)
 	genesis.Epoch = &epoch
+	genesis.EpochUsages = k.GetAllEpochUsage(ctx)
+	genesis.CurrentEpochUsage = k.GetCurrentEpochUsage(ctx)
+	for _, sender := range k.GetCurrentEpochSenders(ctx) {
+		genesis.CurrentEpochSenders = append(genesis.CurrentEpochSenders, sender.String())
+	}
 
 	return genesis
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/testutil/nullify"
 	"github.com/sei-protocol/sei-chain/x/epoch"
@@ -33,3 +34,27 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 }
+
+func TestGenesisUsageRoundTrip(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+
+	k, ctx := keepertest.EpochKeeper(t)
+	epoch.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	k.RecordBlockUsage(ctx, 2, 100, sdk.NewCoins(sdk.NewInt64Coin("usei", 10)), sdk.NewCoins(sdk.NewInt64Coin("usei", 2)), []sdk.AccAddress{alice})
+	k.FinalizeEpochUsage(ctx.WithBlockHeight(10), types.Epoch{CurrentEpoch: 1, CurrentEpochHeight: 1})
+	k.RecordBlockUsage(ctx, 1, 50, sdk.NewCoins(sdk.NewInt64Coin("usei", 5)), sdk.NewCoins(), []sdk.AccAddress{bob})
+
+	exported := epoch.ExportGenesis(ctx, *k)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.EpochUsages, 1)
+	require.Equal(t, []string{bob.String()}, exported.CurrentEpochSenders)
+
+	imported, importedCtx := keepertest.EpochKeeper(t)
+	epoch.InitGenesis(importedCtx, *imported, *exported)
+	require.Equal(t, exported, epoch.ExportGenesis(importedCtx, *imported))
+
+	// senders counted before the export aren't counted again
+	imported.RecordBlockUsage(importedCtx, 1, 50, sdk.NewCoins(), sdk.NewCoins(), []sdk.AccAddress{bob})
+	require.Equal(t, uint64(1), imported.GetCurrentEpochUsage(importedCtx).UniqueSenders)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/epoch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EpochUsage(c context.Context, req *types.QueryEpochUsageRequest) (*types.QueryEpochUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usage, found := k.GetEpochUsage(ctx, req.Epoch)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no usage report for epoch %d", req.Epoch)
+	}
+	return &types.QueryEpochUsageResponse{Usage: usage}, nil
+}
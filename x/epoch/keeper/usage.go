@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/epoch/types"
+)
+
+const (
+	CurrentEpochUsageKey = "current-epoch-usage"
+	EpochUsageKey        = "epoch-usage-"
+	EpochSenderKey       = "epoch-sender-"
+)
+
+// RecordBlockUsage adds a block's transaction activity to the running usage of
+// the current epoch. Senders already seen in the epoch aren't counted again.
+func (k Keeper) RecordBlockUsage(ctx sdk.Context, txCount uint64, gasUsed uint64, fees sdk.Coins, feesBurned sdk.Coins, senders []sdk.AccAddress) {
+	usage := k.GetCurrentEpochUsage(ctx)
+	usage.TxCount += txCount
+	usage.GasUsed += gasUsed
+	usage.FeesCollected = usage.FeesCollected.Add(fees...)
+	usage.FeesBurned = usage.FeesBurned.Add(feesBurned...)
+
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochSenderKey))
+	for _, sender := range senders {
+		if senderStore.Has(sender) {
+			continue
+		}
+		senderStore.Set(sender, []byte{})
+		usage.UniqueSenders++
+	}
+
+	k.SetCurrentEpochUsage(ctx, usage)
+}
+
+// GetCurrentEpochUsage returns the usage accumulated so far in the current
+// epoch. Epoch and height bounds are only filled in once the epoch ends.
+func (k Keeper) GetCurrentEpochUsage(ctx sdk.Context) (usage types.EpochUsage) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(CurrentEpochUsageKey))
+	if b == nil {
+		return types.EpochUsage{FeesCollected: sdk.NewCoins(), FeesBurned: sdk.NewCoins()}
+	}
+	k.cdc.MustUnmarshal(b, &usage)
+	return usage
+}
+
+// SetCurrentEpochUsage replaces the running usage of the current epoch
+func (k Keeper) SetCurrentEpochUsage(ctx sdk.Context, usage types.EpochUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(CurrentEpochUsageKey), k.cdc.MustMarshal(&usage))
+}
+
+// FinalizeEpochUsage stores the usage accumulated during the ending epoch as
+// its report and resets the running usage and sender set for the next one.
+func (k Keeper) FinalizeEpochUsage(ctx sdk.Context, epoch types.Epoch) {
+	usage := k.GetCurrentEpochUsage(ctx)
+	usage.Epoch = epoch.CurrentEpoch
+	usage.StartHeight = epoch.CurrentEpochHeight
+	usage.EndHeight = ctx.BlockHeight() - 1
+	k.SetEpochUsage(ctx, usage)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(CurrentEpochUsageKey))
+
+	senderStore := prefix.NewStore(store, types.KeyPrefix(EpochSenderKey))
+	iterator := senderStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		senderStore.Delete(key)
+	}
+}
+
+// GetCurrentEpochSenders returns the fee payers already counted in the current
+// epoch
+func (k Keeper) GetCurrentEpochSenders(ctx sdk.Context) []sdk.AccAddress {
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochSenderKey))
+	iterator := senderStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	senders := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		senders = append(senders, sdk.AccAddress(iterator.Key()))
+	}
+	return senders
+}
+
+// SetCurrentEpochSender marks a fee payer as counted in the current epoch
+// without changing the running usage
+func (k Keeper) SetCurrentEpochSender(ctx sdk.Context, sender sdk.AccAddress) {
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochSenderKey))
+	senderStore.Set(sender, []byte{})
+}
+
+func (k Keeper) SetEpochUsage(ctx sdk.Context, usage types.EpochUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochUsageKey))
+	store.Set(epochUsageKey(usage.Epoch), k.cdc.MustMarshal(&usage))
+}
+
+// GetEpochUsage returns the usage report of a finished epoch
+func (k Keeper) GetEpochUsage(ctx sdk.Context, epoch uint64) (usage types.EpochUsage, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochUsageKey))
+	b := store.Get(epochUsageKey(epoch))
+	if b == nil {
+		return usage, false
+	}
+	k.cdc.MustUnmarshal(b, &usage)
+	return usage, true
+}
+
+// GetAllEpochUsage returns the usage reports of every finished epoch in epoch
+// order
+func (k Keeper) GetAllEpochUsage(ctx sdk.Context) []types.EpochUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(EpochUsageKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	usages := []types.EpochUsage{}
+	for ; iterator.Valid(); iterator.Next() {
+		usage := types.EpochUsage{}
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+func epochUsageKey(epoch uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, epoch)
+	return key
+}
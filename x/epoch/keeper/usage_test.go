@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/epoch/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEpochUsage(t *testing.T) {
+	keeper, ctx := testkeeper.EpochKeeper(t)
+	alice := sdk.AccAddress([]byte("alice"))
+	bob := sdk.AccAddress([]byte("bob"))
+
+	keeper.RecordBlockUsage(ctx, 2, 100, sdk.NewCoins(sdk.NewInt64Coin("usei", 10)), sdk.NewCoins(sdk.NewInt64Coin("usei", 3)), []sdk.AccAddress{alice, alice})
+	keeper.RecordBlockUsage(ctx, 1, 50, sdk.NewCoins(sdk.NewInt64Coin("usei", 5)), sdk.NewCoins(sdk.NewInt64Coin("usei", 4)), []sdk.AccAddress{bob})
+	require.Equal(t, types.EpochUsage{
+		TxCount:       3,
+		GasUsed:       150,
+		FeesCollected: sdk.NewCoins(sdk.NewInt64Coin("usei", 15)),
+		UniqueSenders: 2,
+		FeesBurned:    sdk.NewCoins(sdk.NewInt64Coin("usei", 7)),
+	}, keeper.GetCurrentEpochUsage(ctx))
+
+	ctx = ctx.WithBlockHeight(20)
+	keeper.FinalizeEpochUsage(ctx, types.Epoch{CurrentEpoch: 3, CurrentEpochHeight: 10})
+	usage, found := keeper.GetEpochUsage(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, types.EpochUsage{
+		Epoch:         3,
+		StartHeight:   10,
+		EndHeight:     19,
+		TxCount:       3,
+		GasUsed:       150,
+		FeesCollected: sdk.NewCoins(sdk.NewInt64Coin("usei", 15)),
+		UniqueSenders: 2,
+		FeesBurned:    sdk.NewCoins(sdk.NewInt64Coin("usei", 7)),
+	}, usage)
+
+	// the next epoch starts from scratch, including the sender set
+	require.Equal(t, types.EpochUsage{FeesCollected: sdk.NewCoins(), FeesBurned: sdk.NewCoins()}, keeper.GetCurrentEpochUsage(ctx))
+	keeper.RecordBlockUsage(ctx, 1, 10, sdk.NewCoins(), sdk.NewCoins(), []sdk.AccAddress{alice})
+	require.Equal(t, uint64(1), keeper.GetCurrentEpochUsage(ctx).UniqueSenders)
+
+	response, err := keeper.EpochUsage(sdk.WrapSDKContext(ctx), &types.QueryEpochUsageRequest{Epoch: 3})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEpochUsageResponse{Usage: usage}, response)
+
+	_, err = keeper.EpochUsage(sdk.WrapSDKContext(ctx), &types.QueryEpochUsageRequest{Epoch: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -170,6 +170,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	ctx.Logger().Info(fmt.Sprintf("Current block time %s, last %s; duration %d", ctx.BlockTime().String(), lastEpoch.CurrentEpochStartTime.String(), lastEpoch.EpochDuration))
 
 	if ctx.BlockTime().Sub(lastEpoch.CurrentEpochStartTime) > lastEpoch.EpochDuration {
+		am.keeper.FinalizeEpochUsage(ctx, lastEpoch)
 		am.keeper.AfterEpochEnd(ctx, lastEpoch)
 
 		newEpoch := types.Epoch{
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // this line is used by starport scaffolding # genesis/types/import
 
@@ -20,6 +25,8 @@ func DefaultGenesis() *GenesisState {
 			CurrentEpochStartTime: now,
 			CurrentEpochHeight:    0,
 		},
+		EpochUsages:       []EpochUsage{},
+		CurrentEpochUsage: EpochUsage{FeesCollected: sdk.NewCoins(), FeesBurned: sdk.NewCoins()},
 	}
 }
 
@@ -31,6 +38,45 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	err = gs.Epoch.Validate()
-	return err
+	if err := gs.Epoch.Validate(); err != nil {
+		return err
+	}
+
+	seenEpochs := map[uint64]bool{}
+	for _, usage := range gs.EpochUsages {
+		if seenEpochs[usage.Epoch] {
+			return fmt.Errorf("duplicate usage report for epoch %d", usage.Epoch)
+		}
+		seenEpochs[usage.Epoch] = true
+		if usage.EndHeight < usage.StartHeight {
+			return fmt.Errorf("epoch %d usage ends at height %d before it starts at %d", usage.Epoch, usage.EndHeight, usage.StartHeight)
+		}
+		if err := usage.FeesCollected.Validate(); err != nil {
+			return err
+		}
+		if err := usage.FeesBurned.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.CurrentEpochUsage.FeesCollected.Validate(); err != nil {
+		return err
+	}
+	if err := gs.CurrentEpochUsage.FeesBurned.Validate(); err != nil {
+		return err
+	}
+	seenSenders := map[string]bool{}
+	for _, sender := range gs.CurrentEpochSenders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return err
+		}
+		if seenSenders[sender] {
+			return fmt.Errorf("duplicate current epoch sender %s", sender)
+		}
+		seenSenders[sender] = true
+	}
+	if uint64(len(gs.CurrentEpochSenders)) != gs.CurrentEpochUsage.UniqueSenders {
+		return fmt.Errorf("current epoch has %d unique senders but %d senders", gs.CurrentEpochUsage.UniqueSenders, len(gs.CurrentEpochSenders))
+	}
+	return nil
 }
@@ -27,6 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Epoch  *Epoch `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// epoch_usages are the usage reports of finished epochs
+	EpochUsages []EpochUsage `protobuf:"bytes,3,rep,name=epoch_usages,json=epochUsages,proto3" json:"epoch_usages"`
+	// current_epoch_usage is the usage accumulated so far in the current epoch
+	CurrentEpochUsage EpochUsage `protobuf:"bytes,4,opt,name=current_epoch_usage,json=currentEpochUsage,proto3" json:"current_epoch_usage"`
+	// current_epoch_senders are the fee payers already counted in the current
+	// epoch's unique senders
+	CurrentEpochSenders []string `protobuf:"bytes,5,rep,name=current_epoch_senders,json=currentEpochSenders,proto3" json:"current_epoch_senders,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +83,27 @@ func (m *GenesisState) GetEpoch() *Epoch {
 	return nil
 }
 
+func (m *GenesisState) GetEpochUsages() []EpochUsage {
+	if m != nil {
+		return m.EpochUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentEpochUsage() EpochUsage {
+	if m != nil {
+		return m.CurrentEpochUsage
+	}
+	return EpochUsage{}
+}
+
+func (m *GenesisState) GetCurrentEpochSenders() []string {
+	if m != nil {
+		return m.CurrentEpochSenders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.epoch.GenesisState")
 }
@@ -83,22 +111,27 @@ func init() {
 func init() { proto.RegisterFile("epoch/genesis.proto", fileDescriptor_ff244678b065710d) }
 
 var fileDescriptor_ff244678b065710d = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x2d, 0xc8, 0x4f,
-	0xce, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x2a, 0x4e, 0xcd, 0x04, 0xb3, 0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12,
-	0x33, 0xf3, 0xf4, 0xc0, 0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16,
-	0x44, 0x87, 0x94, 0x10, 0xc4, 0x98, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x52, 0x82, 0x10,
-	0x31, 0x30, 0x09, 0x11, 0x52, 0xea, 0x64, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x15, 0x5c, 0x92, 0x58,
-	0x92, 0x2a, 0xe4, 0xc0, 0xc5, 0x06, 0xd1, 0x23, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa4,
-	0x87, 0xdb, 0x6a, 0xbd, 0x00, 0xb0, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xfa,
-	0x84, 0xcc, 0xb9, 0x58, 0xc1, 0xb2, 0x12, 0x4c, 0x60, 0x03, 0x14, 0xf1, 0x19, 0xe0, 0x0a, 0x22,
-	0x83, 0x20, 0xea, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x38, 0x35, 0x53, 0x17,
-	0x66, 0x1c, 0x98, 0x03, 0x36, 0x4f, 0xbf, 0x02, 0xe2, 0x2d, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x0a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x75, 0x3f, 0xc0, 0x4d,
-	0x01, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x33, 0xfd, 0x07, 0xdf, 0xb4, 0x9b, 0x4e, 0x3f, 0x21, 0x64, 0x31, 0xc6, 0x2e, 0xa4,
+	0x1b, 0x67, 0xa0, 0x2e, 0xdc, 0x4a, 0x41, 0xc4, 0x95, 0xd2, 0xe2, 0x46, 0x84, 0x92, 0xc6, 0x4b,
+	0x3a, 0x60, 0x33, 0x21, 0x33, 0x05, 0x7d, 0x0b, 0x1f, 0xab, 0xcb, 0x2e, 0x5d, 0x89, 0x34, 0x5b,
+	0x1f, 0x42, 0x72, 0x27, 0x6a, 0xbb, 0x50, 0x71, 0x73, 0xb9, 0xb9, 0xe7, 0x9c, 0x5f, 0x0e, 0x0c,
+	0xed, 0x41, 0xa6, 0xe3, 0xb9, 0x4c, 0x20, 0x05, 0xa3, 0x8c, 0xc8, 0x72, 0x6d, 0x35, 0x0b, 0x0c,
+	0x28, 0xdc, 0x62, 0x7d, 0x2f, 0x0c, 0xa8, 0x78, 0x1e, 0xa9, 0x54, 0xa0, 0x33, 0xf8, 0x9f, 0xe8,
+	0x44, 0xa3, 0x28, 0xcb, 0xcd, 0x25, 0x02, 0xe6, 0x30, 0x59, 0x94, 0x47, 0x8b, 0x8a, 0x12, 0x74,
+	0xdd, 0x0d, 0xe7, 0xee, 0x69, 0x69, 0xa2, 0x04, 0xdc, 0xa9, 0xff, 0x56, 0xa3, 0x9d, 0x73, 0xf7,
+	0xf7, 0x89, 0x8d, 0x2c, 0xb0, 0x53, 0xda, 0x72, 0x18, 0x9f, 0x84, 0x64, 0xd0, 0x1e, 0xf6, 0xc5,
+	0xf7, 0x6d, 0xc4, 0x15, 0x3a, 0x47, 0x8d, 0xd5, 0xcb, 0xbe, 0x37, 0xae, 0x72, 0xec, 0x84, 0x36,
+	0x51, 0xf5, 0x6b, 0x08, 0x38, 0xf8, 0x09, 0x70, 0x56, 0xce, 0xb1, 0xf3, 0xb3, 0x4b, 0xda, 0xc1,
+	0x65, 0x8a, 0x05, 0x8d, 0x5f, 0x0f, 0xeb, 0x83, 0xf6, 0xf0, 0xf0, 0xd7, 0xfc, 0x75, 0x69, 0xaf,
+	0x4a, 0xb4, 0xe1, 0xf3, 0x62, 0xd8, 0x2d, 0xed, 0xc5, 0xcb, 0x3c, 0x87, 0xd4, 0x4e, 0xb7, 0xc0,
+	0x7e, 0x23, 0x24, 0x7f, 0xe6, 0x76, 0x2b, 0xd0, 0x97, 0xc0, 0x86, 0x74, 0x6f, 0x97, 0x6e, 0x20,
+	0xbd, 0x83, 0xdc, 0xf8, 0xcd, 0xb0, 0x3e, 0xf8, 0x37, 0xee, 0x6d, 0x27, 0x26, 0x4e, 0x1a, 0x5d,
+	0xac, 0x36, 0x9c, 0xac, 0x37, 0x9c, 0xbc, 0x6e, 0x38, 0x79, 0x2a, 0xb8, 0xb7, 0x2e, 0xb8, 0xf7,
+	0x5c, 0x70, 0xef, 0x46, 0x26, 0xca, 0xce, 0x97, 0x33, 0x11, 0xeb, 0x85, 0x34, 0xa0, 0x8e, 0x3e,
+	0x9a, 0xe1, 0x07, 0x56, 0x93, 0x0f, 0xee, 0x31, 0xa5, 0x7d, 0xcc, 0xc0, 0xcc, 0x5a, 0xe8, 0x38,
+	0x7e, 0x1f, 0x00, 0x2f, 0x83, 0xba, 0x83, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +154,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CurrentEpochSenders) > 0 {
+		for iNdEx := len(m.CurrentEpochSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentEpochSenders[iNdEx])
+			copy(dAtA[i:], m.CurrentEpochSenders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CurrentEpochSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.CurrentEpochUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EpochUsages) > 0 {
+		for iNdEx := len(m.EpochUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Epoch != nil {
 		{
 			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
@@ -169,6 +235,20 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Epoch.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.EpochUsages) > 0 {
+		for _, e := range m.EpochUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CurrentEpochUsage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CurrentEpochSenders) > 0 {
+		for _, s := range m.CurrentEpochSenders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -276,6 +356,105 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochUsages = append(m.EpochUsages, EpochUsage{})
+			if err := m.EpochUsages[len(m.EpochUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentEpochUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochSenders = append(m.CurrentEpochSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,22 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/epoch/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	withUsage := func(update func(*types.GenesisState)) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.EpochUsages = []types.EpochUsage{{Epoch: 1, StartHeight: 1, EndHeight: 9, FeesCollected: sdk.NewCoins()}}
+		genState.CurrentEpochUsage = types.EpochUsage{UniqueSenders: 1, FeesCollected: sdk.NewCoins()}
+		genState.CurrentEpochSenders = []string{sender}
+		update(genState)
+		return genState
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -23,6 +34,39 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    false,
 		},
+		{
+			desc:     "valid usage",
+			genState: withUsage(func(*types.GenesisState) {}),
+			valid:    true,
+		},
+		{
+			desc: "duplicate epoch usage",
+			genState: withUsage(func(gs *types.GenesisState) {
+				gs.EpochUsages = append(gs.EpochUsages, gs.EpochUsages[0])
+			}),
+			valid: false,
+		},
+		{
+			desc: "epoch usage ends before it starts",
+			genState: withUsage(func(gs *types.GenesisState) {
+				gs.EpochUsages[0].EndHeight = 0
+			}),
+			valid: false,
+		},
+		{
+			desc: "invalid sender",
+			genState: withUsage(func(gs *types.GenesisState) {
+				gs.CurrentEpochSenders = []string{"invalid"}
+			}),
+			valid: false,
+		},
+		{
+			desc: "senders don't match unique sender count",
+			genState: withUsage(func(gs *types.GenesisState) {
+				gs.CurrentEpochUsage.UniqueSenders = 2
+			}),
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
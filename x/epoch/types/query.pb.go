@@ -193,41 +193,136 @@ func (m *QueryEpochResponse) GetEpoch() Epoch {
 	return Epoch{}
 }
 
+type QueryEpochUsageRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryEpochUsageRequest) Reset()         { *m = QueryEpochUsageRequest{} }
+func (m *QueryEpochUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochUsageRequest) ProtoMessage()    {}
+func (*QueryEpochUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05537adf7c5c875f, []int{4}
+}
+func (m *QueryEpochUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochUsageRequest.Merge(m, src)
+}
+func (m *QueryEpochUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochUsageRequest proto.InternalMessageInfo
+
+func (m *QueryEpochUsageRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type QueryEpochUsageResponse struct {
+	Usage EpochUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryEpochUsageResponse) Reset()         { *m = QueryEpochUsageResponse{} }
+func (m *QueryEpochUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochUsageResponse) ProtoMessage()    {}
+func (*QueryEpochUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05537adf7c5c875f, []int{5}
+}
+func (m *QueryEpochUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochUsageResponse.Merge(m, src)
+}
+func (m *QueryEpochUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochUsageResponse proto.InternalMessageInfo
+
+func (m *QueryEpochUsageResponse) GetUsage() EpochUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return EpochUsage{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.epoch.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.epoch.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochRequest)(nil), "seiprotocol.seichain.epoch.QueryEpochRequest")
 	proto.RegisterType((*QueryEpochResponse)(nil), "seiprotocol.seichain.epoch.QueryEpochResponse")
+	proto.RegisterType((*QueryEpochUsageRequest)(nil), "seiprotocol.seichain.epoch.QueryEpochUsageRequest")
+	proto.RegisterType((*QueryEpochUsageResponse)(nil), "seiprotocol.seichain.epoch.QueryEpochUsageResponse")
 }
 
 func init() { proto.RegisterFile("epoch/query.proto", fileDescriptor_05537adf7c5c875f) }
 
 var fileDescriptor_05537adf7c5c875f = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x2b, 0x31,
-	0x18, 0x85, 0x27, 0xe5, 0xb6, 0x8b, 0xdc, 0x55, 0xd3, 0x2e, 0x2e, 0xc3, 0x65, 0xee, 0x75, 0x54,
-	0x90, 0x4a, 0x13, 0x5a, 0xd7, 0x82, 0x14, 0x5c, 0xb8, 0xd3, 0xba, 0x10, 0xdc, 0x65, 0x86, 0x30,
-	0x0d, 0xb4, 0xf3, 0xa7, 0x4d, 0x2a, 0x76, 0xeb, 0x13, 0x88, 0x82, 0x6f, 0xe2, 0x3b, 0x74, 0x59,
-	0x70, 0xe3, 0x4a, 0xa4, 0xf5, 0x41, 0xa4, 0x49, 0x06, 0x2c, 0xc5, 0xda, 0xcd, 0x10, 0xfe, 0x9c,
-	0xf3, 0x9d, 0x33, 0xf9, 0x71, 0x55, 0x28, 0x48, 0x7b, 0x6c, 0x38, 0x16, 0xa3, 0x09, 0x55, 0x23,
-	0x30, 0x40, 0x42, 0x2d, 0xa4, 0x3d, 0xa5, 0xd0, 0xa7, 0x5a, 0xc8, 0xb4, 0xc7, 0x65, 0x4e, 0xad,
-	0x2e, 0xac, 0x67, 0x90, 0x81, 0xbd, 0x64, 0xcb, 0x93, 0x73, 0x84, 0x7f, 0x33, 0x80, 0xac, 0x2f,
-	0x18, 0x57, 0x92, 0xf1, 0x3c, 0x07, 0xc3, 0x8d, 0x84, 0x5c, 0xfb, 0xdb, 0x46, 0x0a, 0x7a, 0x00,
-	0x9a, 0x25, 0x5c, 0x0b, 0x17, 0xc4, 0x6e, 0x5a, 0x89, 0x30, 0xbc, 0xc5, 0x14, 0xcf, 0x64, 0x6e,
-	0xc5, 0x5e, 0x4b, 0x5c, 0x1d, 0xc5, 0x47, 0x7c, 0x50, 0xf8, 0x7d, 0x45, 0xfb, 0x75, 0xa3, 0xb8,
-	0x8e, 0xc9, 0xc5, 0x12, 0x74, 0x6e, 0x75, 0x5d, 0x31, 0x1c, 0x0b, 0x6d, 0xe2, 0x2b, 0x5c, 0x5b,
-	0x99, 0x6a, 0x05, 0xb9, 0x16, 0xe4, 0x04, 0x57, 0x1c, 0xef, 0x0f, 0xfa, 0x8f, 0x0e, 0x7e, 0xb7,
-	0x63, 0xfa, 0xfd, 0x0f, 0x52, 0xe7, 0xed, 0xfc, 0x9a, 0xbe, 0xfd, 0x0b, 0xba, 0xde, 0x17, 0xd7,
-	0x70, 0xd5, 0x82, 0x4f, 0x97, 0x92, 0x22, 0xed, 0xd2, 0x77, 0xf0, 0x43, 0x1f, 0x76, 0x8c, 0xcb,
-	0x16, 0xe4, 0xb3, 0x76, 0x36, 0x65, 0x59, 0xa7, 0x8f, 0x72, 0xae, 0xf6, 0x73, 0x09, 0x97, 0x2d,
-	0x95, 0x3c, 0x20, 0x5c, 0xb6, 0x02, 0xd2, 0xdc, 0xc4, 0x58, 0xeb, 0x15, 0xd2, 0x6d, 0xe5, 0xae,
-	0x71, 0xdc, 0xb8, 0x7b, 0xf9, 0x78, 0x2c, 0xed, 0x91, 0x98, 0x69, 0x21, 0x9b, 0x85, 0x91, 0x15,
-	0x46, 0xf6, 0xe5, 0xf5, 0xc9, 0x13, 0xc2, 0x15, 0xf7, 0x42, 0xe4, 0xe7, 0x98, 0x95, 0xe5, 0x84,
-	0x6c, 0x6b, 0xbd, 0xef, 0x75, 0x68, 0x7b, 0xed, 0x93, 0xdd, 0x8d, 0xbd, 0xdc, 0x86, 0x3a, 0x67,
-	0xd3, 0x79, 0x84, 0x66, 0xf3, 0x08, 0xbd, 0xcf, 0x23, 0x74, 0xbf, 0x88, 0x82, 0xd9, 0x22, 0x0a,
-	0x5e, 0x17, 0x51, 0x70, 0xcd, 0x32, 0x69, 0x7a, 0xe3, 0x84, 0xa6, 0x30, 0x58, 0x03, 0x35, 0x1d,
-	0xe9, 0xd6, 0xb3, 0xcc, 0x44, 0x09, 0x9d, 0x54, 0xac, 0xe2, 0xe8, 0x33, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xcf, 0x45, 0x89, 0x1a, 0x03, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0xeb, 0xd3, 0x30,
+	0x18, 0x6f, 0x74, 0xdd, 0x21, 0x9e, 0x96, 0x0d, 0x95, 0x22, 0x55, 0xeb, 0x0b, 0x32, 0x5d, 0xc2,
+	0xba, 0xb3, 0x20, 0x03, 0x0f, 0xde, 0x74, 0x22, 0x82, 0xe0, 0x21, 0x2d, 0xa1, 0x0b, 0x6c, 0x4d,
+	0xb7, 0xb4, 0xe2, 0x10, 0x2f, 0x7e, 0x02, 0x51, 0xf0, 0x3b, 0xf8, 0x4d, 0x76, 0x1c, 0x78, 0xf1,
+	0x24, 0xba, 0xf9, 0x41, 0xa4, 0x4f, 0x52, 0xd6, 0x31, 0xec, 0xf6, 0xbf, 0x94, 0xf4, 0xc9, 0xef,
+	0x2d, 0xcf, 0xf3, 0xe0, 0x8e, 0xc8, 0x54, 0x3c, 0x65, 0x8b, 0x42, 0x2c, 0x57, 0x34, 0x5b, 0xaa,
+	0x5c, 0x11, 0x4f, 0x0b, 0x09, 0xa7, 0x58, 0xcd, 0xa8, 0x16, 0x32, 0x9e, 0x72, 0x99, 0x52, 0xc0,
+	0x79, 0xbd, 0x44, 0x25, 0x0a, 0x2e, 0x59, 0x79, 0x32, 0x0c, 0xef, 0x46, 0xa2, 0x54, 0x32, 0x13,
+	0x8c, 0x67, 0x92, 0xf1, 0x34, 0x55, 0x39, 0xcf, 0xa5, 0x4a, 0xb5, 0xbd, 0xed, 0xc7, 0x4a, 0xcf,
+	0x95, 0x66, 0x11, 0xd7, 0xc2, 0x18, 0xb1, 0x77, 0xc3, 0x48, 0xe4, 0x7c, 0xc8, 0x32, 0x9e, 0xc8,
+	0x14, 0xc0, 0x16, 0x4b, 0x4c, 0x9c, 0x8c, 0x2f, 0xf9, 0xbc, 0xe2, 0xdb, 0x88, 0xf0, 0x3d, 0x2c,
+	0x15, 0x9a, 0x27, 0xc2, 0x94, 0x82, 0x1e, 0x26, 0x2f, 0x4a, 0xed, 0xe7, 0x40, 0x9d, 0x88, 0x45,
+	0x21, 0x74, 0x1e, 0xbc, 0xc6, 0xdd, 0x83, 0xaa, 0xce, 0x54, 0xaa, 0x05, 0x79, 0x82, 0xdb, 0xc6,
+	0xe2, 0x3a, 0xba, 0x85, 0x1e, 0x5c, 0x09, 0x03, 0xfa, 0xff, 0x37, 0x53, 0xc3, 0x1d, 0xb7, 0xd6,
+	0xbf, 0x6e, 0x3a, 0x13, 0xcb, 0x0b, 0xba, 0xb8, 0x03, 0xc2, 0x4f, 0x4b, 0x48, 0xe5, 0xf6, 0x12,
+	0x93, 0x7a, 0xd1, 0x9a, 0x3d, 0xc6, 0x2e, 0x08, 0x59, 0xaf, 0xdb, 0x4d, 0x5e, 0xc0, 0xb4, 0x56,
+	0x86, 0x15, 0x50, 0x7c, 0x75, 0x2f, 0xfa, 0xaa, 0x7c, 0xb1, 0xb5, 0x23, 0xbd, 0xba, 0x70, 0xab,
+	0xc2, 0xbf, 0xc5, 0xd7, 0x8e, 0xf0, 0x36, 0xc9, 0x18, 0xbb, 0xd0, 0x32, 0x9b, 0xe4, 0xfe, 0xc9,
+	0x24, 0x40, 0xaf, 0xe2, 0x00, 0x35, 0xfc, 0x73, 0x19, 0xbb, 0xa0, 0x4f, 0xbe, 0x20, 0xec, 0x02,
+	0x8a, 0x0c, 0x9a, 0x84, 0x8e, 0xda, 0xe4, 0xd1, 0x73, 0xe1, 0x26, 0x76, 0xd0, 0xff, 0xf4, 0xe3,
+	0xef, 0xd7, 0x4b, 0x77, 0x49, 0xc0, 0xb4, 0x90, 0x83, 0x8a, 0xc8, 0x2a, 0x22, 0xab, 0xed, 0x07,
+	0xf9, 0x86, 0x70, 0xdb, 0x0c, 0x8c, 0x9c, 0xb6, 0x39, 0xd8, 0x15, 0x8f, 0x9d, 0x8d, 0xb7, 0xb9,
+	0x1e, 0x42, 0xae, 0x7b, 0xe4, 0x4e, 0x63, 0x2e, 0xb3, 0x30, 0xe4, 0x3b, 0xc2, 0x78, 0xdf, 0x53,
+	0x12, 0x9e, 0xd7, 0x83, 0xfa, 0xbc, 0xbd, 0xd1, 0x85, 0x38, 0x36, 0x64, 0x08, 0x21, 0x1f, 0x91,
+	0x7e, 0x63, 0x48, 0x98, 0x2d, 0xfb, 0x00, 0x3f, 0x1f, 0xc7, 0xcf, 0xd6, 0x5b, 0x1f, 0x6d, 0xb6,
+	0x3e, 0xfa, 0xbd, 0xf5, 0xd1, 0xe7, 0x9d, 0xef, 0x6c, 0x76, 0xbe, 0xf3, 0x73, 0xe7, 0x3b, 0x6f,
+	0x58, 0x22, 0xf3, 0x69, 0x11, 0xd1, 0x58, 0xcd, 0x8f, 0xf4, 0x06, 0x46, 0xf0, 0xbd, 0x95, 0xcc,
+	0x57, 0x99, 0xd0, 0x51, 0x1b, 0x10, 0xa3, 0x7f, 0x03, 0x00, 0x2d, 0xf4, 0xe9, 0x49, 0x68, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -246,6 +341,8 @@ type QueryClient interface {
 	Epoch(ctx context.Context, in *QueryEpochRequest, opts ...grpc.CallOption) (*QueryEpochResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query the usage report of a finished epoch
+	EpochUsage(ctx context.Context, in *QueryEpochUsageRequest, opts ...grpc.CallOption) (*QueryEpochUsageResponse, error)
 }
 
 type queryClient struct {
@@ -274,12 +371,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EpochUsage(ctx context.Context, in *QueryEpochUsageRequest, opts ...grpc.CallOption) (*QueryEpochUsageResponse, error) {
+	out := new(QueryEpochUsageResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.epoch.Query/EpochUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query the epoch in the chain
 	Epoch(context.Context, *QueryEpochRequest) (*QueryEpochResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query the usage report of a finished epoch
+	EpochUsage(context.Context, *QueryEpochUsageRequest) (*QueryEpochUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -292,6 +400,9 @@ func (*UnimplementedQueryServer) Epoch(ctx context.Context, req *QueryEpochReque
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) EpochUsage(ctx context.Context, req *QueryEpochUsageRequest) (*QueryEpochUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -333,6 +444,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.epoch.Query/EpochUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochUsage(ctx, req.(*QueryEpochUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.epoch.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -345,6 +474,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EpochUsage",
+			Handler:    _Query_EpochUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epoch/query.proto",
@@ -462,6 +595,67 @@ func (m *QueryEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -513,6 +707,29 @@ func (m *QueryEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryEpochUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -785,6 +1002,158 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: epoch/usage.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochUsage aggregates the transaction activity of a single epoch.
+type EpochUsage struct {
+	Epoch       uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch" yaml:"epoch"`
+	StartHeight int64  `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height" yaml:"start_height"`
+	EndHeight   int64  `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height" yaml:"end_height"`
+	TxCount     uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count" yaml:"tx_count"`
+	GasUsed     uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used" yaml:"gas_used"`
+	// fees_collected is the total amount that reached the fee collector
+	FeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_collected" yaml:"fees_collected"`
+	// unique_senders counts distinct fee payers
+	UniqueSenders uint64 `protobuf:"varint,7,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders" yaml:"unique_senders"`
+	// fees_burned is the total amount burned by blob fees
+	FeesBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fees_burned,json=feesBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_burned" yaml:"fees_burned"`
+}
+
+func (m *EpochUsage) Reset()         { *m = EpochUsage{} }
+func (m *EpochUsage) String() string { return proto.CompactTextString(m) }
+func (*EpochUsage) ProtoMessage()    {}
+func (*EpochUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73fa890100e6d964, []int{0}
+}
+func (m *EpochUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUsage.Merge(m, src)
+}
+func (m *EpochUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUsage proto.InternalMessageInfo
+
+func (m *EpochUsage) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
+}
+
+func (m *EpochUsage) GetUniqueSenders() uint64 {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return 0
+}
+
+func (m *EpochUsage) GetFeesBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesBurned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EpochUsage)(nil), "seiprotocol.seichain.epoch.EpochUsage")
+}
+
+func init() { proto.RegisterFile("epoch/usage.proto", fileDescriptor_73fa890100e6d964) }
+
+var fileDescriptor_73fa890100e6d964 = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x1b, 0xba, 0xae, 0xc3, 0xdd, 0x86, 0x16, 0x40, 0xca, 0x7a, 0x88, 0xab, 0x70, 0xa0,
+	0x12, 0x5a, 0xac, 0xc1, 0x6d, 0xc7, 0x54, 0x48, 0xc0, 0x31, 0xa8, 0x17, 0x38, 0x54, 0xf9, 0xf3,
+	0x92, 0x44, 0xb4, 0x71, 0xa9, 0x1d, 0xd4, 0x7d, 0x0b, 0xf8, 0x00, 0x7c, 0x01, 0x3e, 0xc9, 0x2e,
+	0x48, 0x3b, 0x72, 0x32, 0xa8, 0xbd, 0xe5, 0x98, 0x4f, 0x80, 0x6c, 0x27, 0x6b, 0xca, 0x05, 0x71,
+	0x8a, 0xdf, 0xc7, 0x79, 0x7e, 0xf6, 0xab, 0x57, 0x46, 0x67, 0xb0, 0xa4, 0x51, 0x4a, 0x0a, 0x16,
+	0x24, 0xe0, 0x2e, 0x57, 0x94, 0x53, 0x73, 0xc8, 0x20, 0x53, 0xab, 0x88, 0xce, 0x5d, 0x06, 0x59,
+	0x94, 0x06, 0x59, 0xee, 0xaa, 0xff, 0x86, 0x8f, 0x12, 0x9a, 0x50, 0xb5, 0x49, 0xe4, 0x4a, 0x1b,
+	0x43, 0x3b, 0xa2, 0x6c, 0x41, 0x19, 0x09, 0x03, 0x06, 0xe4, 0xf3, 0x65, 0x08, 0x3c, 0xb8, 0x24,
+	0x11, 0xcd, 0x72, 0xbd, 0xef, 0xfc, 0xe8, 0x21, 0xf4, 0x52, 0xfa, 0x53, 0x79, 0x8c, 0x49, 0x50,
+	0x4f, 0xa5, 0x59, 0xc6, 0xc8, 0x18, 0x1f, 0x78, 0xe7, 0xa5, 0xc0, 0x1a, 0x54, 0x02, 0x1f, 0x5f,
+	0x07, 0x8b, 0xf9, 0x95, 0xa3, 0x4a, 0xc7, 0xd7, 0xd8, 0x7c, 0x83, 0x8e, 0x19, 0x0f, 0x56, 0x7c,
+	0x96, 0x42, 0x96, 0xa4, 0xdc, 0xba, 0x37, 0x32, 0xc6, 0x5d, 0xef, 0x69, 0x29, 0xf0, 0x1e, 0xaf,
+	0x04, 0x7e, 0xa8, 0xf5, 0x36, 0x75, 0xfc, 0x81, 0x2a, 0x5f, 0xa9, 0xca, 0xf4, 0x10, 0x82, 0x3c,
+	0x6e, 0x92, 0xba, 0x2a, 0xe9, 0x49, 0x29, 0x70, 0x8b, 0x56, 0x02, 0x9f, 0xd5, 0xd7, 0xb8, 0x63,
+	0x8e, 0x7f, 0x1f, 0xf2, 0xb8, 0xce, 0xb8, 0x42, 0x47, 0x7c, 0x3d, 0x8b, 0x68, 0x91, 0x73, 0xeb,
+	0x40, 0xf5, 0x80, 0x4b, 0x81, 0xef, 0x58, 0x25, 0xf0, 0x03, 0xed, 0x37, 0xc4, 0xf1, 0xfb, 0x7c,
+	0x3d, 0x91, 0x2b, 0xe9, 0x26, 0x01, 0x9b, 0x15, 0x0c, 0x62, 0xab, 0xb7, 0x73, 0x1b, 0xb6, 0x73,
+	0x1b, 0xe2, 0xf8, 0xfd, 0x24, 0x60, 0x53, 0x06, 0xb1, 0xf9, 0xcd, 0x40, 0xa7, 0x1f, 0x00, 0xd8,
+	0x2c, 0xa2, 0xf3, 0x39, 0x44, 0x1c, 0x62, 0xeb, 0x70, 0xd4, 0x1d, 0x0f, 0x9e, 0x9f, 0xbb, 0x7a,
+	0x02, 0xae, 0x9c, 0x80, 0x5b, 0x4f, 0xc0, 0x9d, 0xd0, 0x2c, 0xf7, 0xde, 0xdf, 0x08, 0xdc, 0x29,
+	0x05, 0xfe, 0x4b, 0xac, 0x04, 0x7e, 0xac, 0xcf, 0xd9, 0xe7, 0xce, 0xf7, 0x5f, 0x78, 0x9c, 0x64,
+	0x3c, 0x2d, 0x42, 0x37, 0xa2, 0x0b, 0x52, 0x4f, 0x56, 0x7f, 0x2e, 0x58, 0xfc, 0x91, 0xf0, 0xeb,
+	0x25, 0x30, 0x95, 0xcd, 0xfc, 0x13, 0x29, 0x4f, 0x1a, 0xd7, 0xf4, 0xd1, 0x69, 0x91, 0x67, 0x9f,
+	0x0a, 0x98, 0x31, 0xc8, 0x63, 0x58, 0x31, 0xab, 0xaf, 0x3a, 0x7c, 0x26, 0xcf, 0xdf, 0xdf, 0xd9,
+	0x9d, 0xbf, 0xcf, 0x1d, 0xff, 0x44, 0x83, 0xb7, 0xba, 0x36, 0xbf, 0x1a, 0x68, 0xa0, 0xae, 0x18,
+	0x16, 0xab, 0x1c, 0x62, 0xeb, 0xe8, 0x5f, 0x0d, 0x4f, 0xeb, 0x86, 0xdb, 0x56, 0x25, 0xb0, 0xd9,
+	0xea, 0x56, 0xc3, 0xff, 0x6b, 0x15, 0x49, 0xd3, 0x53, 0xa2, 0xf7, 0xfa, 0x66, 0x63, 0x1b, 0xb7,
+	0x1b, 0xdb, 0xf8, 0xbd, 0xb1, 0x8d, 0x2f, 0x5b, 0xbb, 0x73, 0xbb, 0xb5, 0x3b, 0x3f, 0xb7, 0x76,
+	0xe7, 0x1d, 0x69, 0xe5, 0x31, 0xc8, 0x2e, 0x9a, 0x77, 0xa4, 0x0a, 0xf5, 0x90, 0xc8, 0x9a, 0xe8,
+	0x27, 0xa7, 0xc2, 0xc3, 0x43, 0xf5, 0xc7, 0x8b, 0x3f, 0x03, 0x00, 0xe7, 0x1f, 0xa8, 0xa4, 0x88,
+	0x03, 0x00, 0x00,
+}
+
+func (m *EpochUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesBurned) > 0 {
+		for iNdEx := len(m.FeesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.UniqueSenders != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.UniqueSenders))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintUsage(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUsage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUsage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovUsage(uint64(m.Epoch))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovUsage(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovUsage(uint64(m.EndHeight))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovUsage(uint64(m.TxCount))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovUsage(uint64(m.GasUsed))
+	}
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovUsage(uint64(l))
+		}
+	}
+	if m.UniqueSenders != 0 {
+		n += 1 + sovUsage(uint64(m.UniqueSenders))
+	}
+	if len(m.FeesBurned) > 0 {
+		for _, e := range m.FeesBurned {
+			l = e.Size()
+			n += 1 + l + sovUsage(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovUsage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUsage(x uint64) (n int) {
+	return sovUsage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCollected = append(m.FeesCollected, types.Coin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenders", wireType)
+			}
+			m.UniqueSenders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueSenders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesBurned = append(m.FeesBurned, types.Coin{})
+			if err := m.FeesBurned[len(m.FeesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUsage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUsage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUsage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUsage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUsage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUsage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUsage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,18 @@
+package seqhistory
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/keeper"
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+// EndBlocker prunes the records that are past retention.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.PruneSequenceRecords(ctx)
+}
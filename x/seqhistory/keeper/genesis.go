@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+// InitGenesis initializes the seqhistory module's state from a provided
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, record := range genState.Records {
+		k.SetSequenceRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns the seqhistory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:  k.GetParams(ctx),
+		Records: k.GetAllSequenceRecords(ctx),
+	}
+}
@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genState := types.GenesisState{
+		Params: types.Params{RetentionBlocks: 100, MaxPrunesPerBlock: 10},
+		Records: []types.SequenceRecord{
+			types.NewSequenceRecord(suite.TestAccs[0].String(), 0, types.TxHash([]byte("tx0")), 1),
+			types.NewSequenceRecord(suite.TestAccs[0].String(), 1, types.TxHash([]byte("tx1")), 2),
+		},
+	}
+	suite.Require().NoError(genState.Validate())
+
+	suite.App.SeqHistoryKeeper.InitGenesis(suite.Ctx, genState)
+	suite.Require().Equal(&genState, suite.App.SeqHistoryKeeper.ExportGenesis(suite.Ctx))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: k.GetParams(sdkCtx)}, nil
+}
+
+func (k Keeper) SequenceRecord(ctx context.Context, req *types.QuerySequenceRecordRequest) (*types.QuerySequenceRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record, found := k.GetSequenceRecord(sdkCtx, addr, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrRecordNotFound.Error())
+	}
+	return &types.QuerySequenceRecordResponse{Record: record}, nil
+}
+
+func (k Keeper) SequenceRecords(ctx context.Context, req *types.QuerySequenceRecordsRequest) (*types.QuerySequenceRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	records, pageRes, err := k.GetSequenceRecordsPaginated(sdkCtx, addr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySequenceRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
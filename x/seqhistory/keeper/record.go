@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+// RecordTxSequences records the sequences a tx consumed at the current height.
+// The ante handler emits a tx event with an acc_seq attribute of
+// "<address>/<sequence>" for every signer, and a tx only carries those events
+// if its ante handler succeeded, i.e. if it did consume the sequences, so txs
+// whose msgs failed are recorded too while txs rejected by the ante handler
+// are not.
+func (k Keeper) RecordTxSequences(ctx sdk.Context, txHash string, events []abci.Event) {
+	for _, event := range events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != sdk.AttributeKeyAccountSequence {
+				continue
+			}
+			addr, sequence, ok := parseAccountSequence(string(attr.Value))
+			if !ok {
+				k.Logger(ctx).Error("unparsable account sequence", "value", string(attr.Value), "tx", txHash)
+				continue
+			}
+			k.SetSequenceRecord(ctx, types.NewSequenceRecord(addr.String(), sequence, txHash, ctx.BlockHeight()))
+		}
+	}
+}
+
+func parseAccountSequence(value string) (sdk.AccAddress, uint64, bool) {
+	separator := strings.LastIndex(value, "/")
+	if separator < 0 {
+		return nil, 0, false
+	}
+	addr, err := sdk.AccAddressFromBech32(value[:separator])
+	if err != nil {
+		return nil, 0, false
+	}
+	sequence, err := strconv.ParseUint(value[separator+1:], 10, 64)
+	if err != nil {
+		return nil, 0, false
+	}
+	return addr, sequence, true
+}
+
+// SetSequenceRecord stores a record, replacing any earlier record of the same
+// account sequence
+func (k Keeper) SetSequenceRecord(ctx sdk.Context, record types.SequenceRecord) {
+	addr := sdk.MustAccAddressFromBech32(record.Address)
+	if old, found := k.GetSequenceRecord(ctx, addr, record.Sequence); found {
+		k.heightIndexStore(ctx).Delete(types.HeightIndexKeySuffix(old.Height, addr, old.Sequence))
+	}
+	k.recordStore(ctx).Set(types.SequenceRecordKeySuffix(addr, record.Sequence), k.cdc.MustMarshal(&record))
+	k.heightIndexStore(ctx).Set(types.HeightIndexKeySuffix(record.Height, addr, record.Sequence), []byte{})
+}
+
+// GetSequenceRecord returns the record of the tx that consumed an account
+// sequence
+func (k Keeper) GetSequenceRecord(ctx sdk.Context, addr sdk.AccAddress, sequence uint64) (types.SequenceRecord, bool) {
+	bz := k.recordStore(ctx).Get(types.SequenceRecordKeySuffix(addr, sequence))
+	if bz == nil {
+		return types.SequenceRecord{}, false
+	}
+	record := types.SequenceRecord{}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetSequenceRecordsPaginated returns a page of an account's records in
+// sequence order
+func (k Keeper) GetSequenceRecordsPaginated(ctx sdk.Context, addr sdk.AccAddress, page *query.PageRequest) (records []types.SequenceRecord, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(k.recordStore(ctx), types.AccountRecordPrefix(addr))
+
+	records = []types.SequenceRecord{}
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		record := types.SequenceRecord{}
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+
+	return
+}
+
+// GetAllSequenceRecords returns every retained record
+func (k Keeper) GetAllSequenceRecords(ctx sdk.Context) []types.SequenceRecord {
+	iterator := k.recordStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.SequenceRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SequenceRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// PruneSequenceRecords deletes up to max_prunes_per_block of the oldest
+// records that were recorded more than retention_blocks blocks ago
+func (k Keeper) PruneSequenceRecords(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	cutoff := ctx.BlockHeight() - int64(params.RetentionBlocks)
+	if params.RetentionBlocks == 0 || cutoff <= 0 {
+		return
+	}
+
+	indexStore := k.heightIndexStore(ctx)
+	iterator := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff)+1))
+	expired := [][]byte{}
+	for ; iterator.Valid() && uint64(len(expired)) < params.MaxPrunesPerBlock; iterator.Next() {
+		expired = append(expired, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	recordStore := k.recordStore(ctx)
+	for _, indexKey := range expired {
+		_, recordKey := types.ParseHeightIndexKeySuffix(indexKey)
+		recordStore.Delete(recordKey)
+		indexStore.Delete(indexKey)
+	}
+}
+
+func (k Keeper) recordStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SequenceRecordPrefix())
+}
+
+func (k Keeper) heightIndexStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.HeightIndexPrefix())
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+func accSeqEvent(values ...string) abci.Event {
+	event := abci.Event{Type: sdk.EventTypeTx}
+	for _, value := range values {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   []byte(sdk.AttributeKeyAccountSequence),
+			Value: []byte(value),
+		})
+	}
+	return event
+}
+
+func (suite *KeeperTestSuite) TestRecordTxSequences() {
+	k := suite.App.SeqHistoryKeeper
+	ctx := suite.Ctx.WithBlockHeight(7)
+	first, second := suite.TestAccs[0], suite.TestAccs[1]
+	txHash := types.TxHash([]byte("multisigned"))
+
+	k.RecordTxSequences(ctx, txHash, []abci.Event{
+		{Type: sdk.EventTypeMessage, Attributes: []abci.EventAttribute{
+			{Key: []byte(sdk.AttributeKeyAccountSequence), Value: []byte(fmt.Sprintf("%s/1", second))},
+		}},
+		accSeqEvent(fmt.Sprintf("%s/57", first), "not-an-account-sequence", fmt.Sprintf("%s/x", first)),
+		accSeqEvent(fmt.Sprintf("%s/3", second)),
+	})
+
+	record, found := k.GetSequenceRecord(ctx, first, 57)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewSequenceRecord(first.String(), 57, txHash, 7), record)
+	record, found = k.GetSequenceRecord(ctx, second, 3)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewSequenceRecord(second.String(), 3, txHash, 7), record)
+	// only tx events carry consumed sequences
+	_, found = k.GetSequenceRecord(ctx, second, 1)
+	suite.Require().False(found)
+	suite.Require().Len(k.GetAllSequenceRecords(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestPruneSequenceRecords() {
+	k := suite.App.SeqHistoryKeeper
+	k.SetParams(suite.Ctx, types.Params{RetentionBlocks: 10, MaxPrunesPerBlock: 2})
+	addr := suite.TestAccs[0]
+	for sequence := uint64(0); sequence < 4; sequence++ {
+		k.SetSequenceRecord(suite.Ctx, types.NewSequenceRecord(addr.String(), sequence, types.TxHash([]byte{byte(sequence)}), int64(sequence+1)))
+	}
+	// recording a sequence again moves it to the new height
+	k.SetSequenceRecord(suite.Ctx, types.NewSequenceRecord(addr.String(), 0, types.TxHash([]byte("again")), 6))
+
+	sequences := func() []uint64 {
+		sequences := []uint64{}
+		for _, record := range k.GetAllSequenceRecords(suite.Ctx) {
+			sequences = append(sequences, record.Sequence)
+		}
+		return sequences
+	}
+
+	// nothing is past retention yet
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(11))
+	suite.Require().Equal([]uint64{0, 1, 2, 3}, sequences())
+
+	// heights 2 to 4 are past retention, but only two records are pruned per block
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(14))
+	suite.Require().Equal([]uint64{0, 3}, sequences())
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(14))
+	suite.Require().Equal([]uint64{0}, sequences())
+
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(15))
+	suite.Require().Equal([]uint64{0}, sequences())
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(16))
+	suite.Require().Empty(sequences())
+
+	// a retention of 0 keeps records forever
+	k.SetParams(suite.Ctx, types.Params{RetentionBlocks: 0, MaxPrunesPerBlock: 2})
+	k.SetSequenceRecord(suite.Ctx, types.NewSequenceRecord(addr.String(), 4, types.TxHash([]byte{4}), 1))
+	k.PruneSequenceRecords(suite.Ctx.WithBlockHeight(1000000))
+	suite.Require().Equal([]uint64{4}, sequences())
+}
+
+func (suite *KeeperTestSuite) TestSequenceRecordQueries() {
+	k := suite.App.SeqHistoryKeeper
+	wctx := sdk.WrapSDKContext(suite.Ctx)
+	addr, other := suite.TestAccs[0], suite.TestAccs[1]
+	expected := []types.SequenceRecord{}
+	for sequence := uint64(0); sequence < 5; sequence++ {
+		record := types.NewSequenceRecord(addr.String(), sequence, types.TxHash([]byte{byte(sequence)}), 1)
+		k.SetSequenceRecord(suite.Ctx, record)
+		expected = append(expected, record)
+	}
+	k.SetSequenceRecord(suite.Ctx, types.NewSequenceRecord(other.String(), 0, types.TxHash([]byte("other")), 1))
+
+	res, err := k.SequenceRecord(wctx, &types.QuerySequenceRecordRequest{Address: addr.String(), Sequence: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[3], res.Record)
+	_, err = k.SequenceRecord(wctx, &types.QuerySequenceRecordRequest{Address: addr.String(), Sequence: 5})
+	suite.Require().Error(err)
+	_, err = k.SequenceRecord(wctx, &types.QuerySequenceRecordRequest{Address: "sei1invalid", Sequence: 0})
+	suite.Require().Error(err)
+
+	page, err := k.SequenceRecords(wctx, &types.QuerySequenceRecordsRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[:3], page.Records)
+	suite.Require().Equal(uint64(5), page.Pagination.Total)
+	page, err = k.SequenceRecords(wctx, &types.QuerySequenceRecordsRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[3:], page.Records)
+}
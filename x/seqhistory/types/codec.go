@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func RegisterCodec(_ *codec.LegacyAmino) {}
+
+func RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
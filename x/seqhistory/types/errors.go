@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/seqhistory module sentinel errors
+var (
+	ErrRecordNotFound = sdkerrors.Register(ModuleName, 2, "sequence record not found")
+	ErrInvalidTxHash  = sdkerrors.Register(ModuleName, 3, "tx hash must be an upper case hex SHA-256 hash")
+)
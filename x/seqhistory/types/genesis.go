@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default seqhistory genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:  DefaultParams(),
+		Records: []SequenceRecord{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, record := range gs.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		id := fmt.Sprintf("%s/%d", record.Address, record.Sequence)
+		if seen[id] {
+			return fmt.Errorf("duplicate record for address %s and sequence %d", record.Address, record.Sequence)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/seqhistory/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("sequence_history____")).String()
+	txHash := types.TxHash([]byte("tx"))
+	record := types.NewSequenceRecord(addr, 57, txHash, 10)
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid records",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{record, types.NewSequenceRecord(addr, 58, txHash, 10)},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate record",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{record, types.NewSequenceRecord(addr, 57, txHash, 11)},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid address",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{types.NewSequenceRecord("sei1invalid", 57, txHash, 10)},
+			},
+			valid: false,
+		},
+		{
+			desc: "lower case tx hash",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{types.NewSequenceRecord(addr, 57, "0a"+txHash[2:], 10)},
+			},
+			valid: false,
+		},
+		{
+			desc: "short tx hash",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{types.NewSequenceRecord(addr, 57, txHash[:16], 10)},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero height",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.SequenceRecord{types.NewSequenceRecord(addr, 57, txHash, 0)},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max prunes per block",
+			genState: &types.GenesisState{
+				Params:  types.Params{RetentionBlocks: 10, MaxPrunesPerBlock: 0},
+				Records: []types.SequenceRecord{},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
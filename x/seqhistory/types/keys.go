@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "seqhistory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the seqhistory module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+const (
+	SequenceRecordKey = "sequence-record-"
+	HeightIndexKey    = "height-index-"
+)
+
+// SequenceRecordPrefix returns the store prefix under which records are keyed
+// by length-prefixed address bytes followed by the big endian sequence
+func SequenceRecordPrefix() []byte {
+	return []byte(SequenceRecordKey)
+}
+
+// AccountRecordPrefix returns the prefix of all records of an account,
+// relative to SequenceRecordPrefix
+func AccountRecordPrefix(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
+
+// SequenceRecordKeySuffix returns the key of an account's record for a
+// sequence, relative to SequenceRecordPrefix
+func SequenceRecordKeySuffix(addr sdk.AccAddress, sequence uint64) []byte {
+	return append(AccountRecordPrefix(addr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// HeightIndexPrefix returns the store prefix of the index of records by the
+// height they were recorded at, which pruning walks in height order
+func HeightIndexPrefix() []byte {
+	return []byte(HeightIndexKey)
+}
+
+// HeightIndexKeySuffix returns the index key of a record, relative to
+// HeightIndexPrefix. The record's key follows the big endian height.
+func HeightIndexKeySuffix(height int64, addr sdk.AccAddress, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), SequenceRecordKeySuffix(addr, sequence)...)
+}
+
+// ParseHeightIndexKeySuffix returns the height of an index key relative to
+// HeightIndexPrefix and the key of the record it indexes relative to
+// SequenceRecordPrefix
+func ParseHeightIndexKeySuffix(key []byte) (int64, []byte) {
+	return int64(binary.BigEndian.Uint64(key[:8])), key[8:]
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	KeyRetentionBlocks   = []byte("RetentionBlocks")
+	KeyMaxPrunesPerBlock = []byte("MaxPrunesPerBlock")
+)
+
+const (
+	// about a week of blocks at 0.4s per block
+	DefaultRetentionBlocks   = 1500000
+	DefaultMaxPrunesPerBlock = 1000
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the seqhistory module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		RetentionBlocks:   DefaultRetentionBlocks,
+		MaxPrunesPerBlock: DefaultMaxPrunesPerBlock,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetentionBlocks, &p.RetentionBlocks, validateRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxPrunesPerBlock, &p.MaxPrunesPerBlock, validateMaxPrunesPerBlock),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateRetentionBlocks(p.RetentionBlocks); err != nil {
+		return err
+	}
+	return validateMaxPrunesPerBlock(p.MaxPrunesPerBlock)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPrunesPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max prunes per block must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func NewSequenceRecord(addr string, sequence uint64, txHash string, height int64) SequenceRecord {
+	return SequenceRecord{
+		Address:  addr,
+		Sequence: sequence,
+		TxHash:   txHash,
+		Height:   height,
+	}
+}
+
+// TxHash returns the hash tendermint identifies the tx by
+func TxHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
+
+func (r SequenceRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if hash, err := hex.DecodeString(r.TxHash); err != nil || len(hash) != tmhash.Size || strings.ToUpper(r.TxHash) != r.TxHash {
+		return ErrInvalidTxHash
+	}
+	if r.Height <= 0 {
+		return fmt.Errorf("record height must be positive, got %d", r.Height)
+	}
+	return nil
+}
@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: seqhistory/seqhistory.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SequenceRecord records the tx that consumed an account sequence.
+type SequenceRecord struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence" yaml:"sequence"`
+	// tx_hash is the upper case hex SHA-256 hash of the tx bytes, as used by
+	// tendermint's tx queries
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash" yaml:"tx_hash"`
+	Height int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *SequenceRecord) Reset()         { *m = SequenceRecord{} }
+func (m *SequenceRecord) String() string { return proto.CompactTextString(m) }
+func (*SequenceRecord) ProtoMessage()    {}
+func (*SequenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3b0519a50d8c13, []int{0}
+}
+func (m *SequenceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceRecord.Merge(m, src)
+}
+func (m *SequenceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceRecord proto.InternalMessageInfo
+
+func (m *SequenceRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SequenceRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SequenceRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *SequenceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SequenceRecord)(nil), "seiprotocol.seichain.seqhistory.SequenceRecord")
+}
+
+func init() { proto.RegisterFile("seqhistory/seqhistory.proto", fileDescriptor_0f3b0519a50d8c13) }
+
+var fileDescriptor_0f3b0519a50d8c13 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x1c, 0xc4, 0xeb, 0xaf, 0x55, 0xfb, 0x61, 0x89, 0x22, 0x45, 0x0c, 0x11, 0x15, 0x76, 0xe4, 0x29,
+	0x0b, 0xc9, 0x50, 0x04, 0x12, 0x6c, 0x99, 0x98, 0x18, 0xc2, 0xc6, 0x82, 0xd2, 0xc4, 0x8a, 0x2d,
+	0xb5, 0x75, 0x1b, 0xbb, 0x52, 0xf2, 0x16, 0x3c, 0x16, 0x63, 0x47, 0x26, 0x0b, 0x25, 0x5b, 0xc6,
+	0xf0, 0x02, 0x88, 0xc4, 0x69, 0xd8, 0xce, 0xbf, 0xbb, 0xf3, 0x5f, 0x3a, 0xb8, 0x90, 0x74, 0xcf,
+	0xb8, 0x54, 0x22, 0x2b, 0xfc, 0x41, 0x7a, 0xbb, 0x4c, 0x28, 0x61, 0x61, 0x49, 0x79, 0xab, 0x62,
+	0xb1, 0xf6, 0x24, 0xe5, 0x31, 0x8b, 0xf8, 0xd6, 0x1b, 0x62, 0x57, 0x97, 0xa9, 0x48, 0x45, 0x9b,
+	0xf0, 0x7f, 0x55, 0x57, 0x23, 0xdf, 0x00, 0xce, 0x5f, 0xe8, 0xfe, 0x40, 0xb7, 0x31, 0x0d, 0x69,
+	0x2c, 0xb2, 0xc4, 0xba, 0x87, 0xb3, 0x28, 0x49, 0x32, 0x2a, 0xa5, 0x0d, 0x1c, 0xe0, 0x9e, 0x05,
+	0xd7, 0xb5, 0xc6, 0x3d, 0x6a, 0x34, 0x9e, 0x17, 0xd1, 0x66, 0xfd, 0x40, 0x0c, 0x20, 0x61, 0x6f,
+	0x59, 0x8f, 0xf0, 0xbf, 0x34, 0x5f, 0xd9, 0xff, 0x1c, 0xe0, 0x4e, 0x02, 0x5c, 0x6b, 0x7c, 0x62,
+	0x8d, 0xc6, 0x17, 0x5d, 0xb5, 0x27, 0x24, 0x3c, 0x99, 0xd6, 0x1d, 0x9c, 0xa9, 0xfc, 0x8d, 0x45,
+	0x92, 0xd9, 0xe3, 0xe1, 0xaa, 0x41, 0xc3, 0x55, 0x03, 0x48, 0x38, 0x55, 0xf9, 0x53, 0x24, 0x99,
+	0xb5, 0x84, 0x53, 0x46, 0x79, 0xca, 0x94, 0x3d, 0x71, 0x80, 0x3b, 0x0e, 0x16, 0xb5, 0xc6, 0x86,
+	0x34, 0x1a, 0x9f, 0x77, 0xad, 0xee, 0x4d, 0x42, 0x63, 0x04, 0xcf, 0x1f, 0x25, 0x02, 0xc7, 0x12,
+	0x81, 0xaf, 0x12, 0x81, 0xf7, 0x0a, 0x8d, 0x8e, 0x15, 0x1a, 0x7d, 0x56, 0x68, 0xf4, 0x7a, 0x9b,
+	0x72, 0xc5, 0x0e, 0x2b, 0x2f, 0x16, 0x1b, 0x5f, 0x52, 0x7e, 0xd3, 0x4f, 0xda, 0x3e, 0xda, 0x4d,
+	0xfd, 0xfc, 0xcf, 0xf8, 0xbe, 0x2a, 0x76, 0x54, 0xae, 0xa6, 0x6d, 0x6c, 0xf9, 0x33, 0x00, 0x49,
+	0xbc, 0xa8, 0xad, 0xa2, 0x01, 0x00, 0x00,
+}
+
+func (m *SequenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSeqhistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSeqhistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintSeqhistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSeqhistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSeqhistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSeqhistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SequenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSeqhistory(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSeqhistory(uint64(m.Sequence))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSeqhistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSeqhistory(uint64(m.Height))
+	}
+	return n
+}
+
+func sovSeqhistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSeqhistory(x uint64) (n int) {
+	return sovSeqhistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SequenceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeqhistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeqhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeqhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeqhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeqhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeqhistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeqhistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSeqhistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSeqhistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSeqhistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSeqhistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSeqhistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSeqhistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSeqhistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSeqhistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSeqhistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,91 @@
+package tagging
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/tagging/keeper"
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+// TaggedAddressDecorator rejects txs that are signed by a tagged address, have
+// their fees granted by one, send funds to one, or call or take over a tagged
+// contract, if the RejectTaggedTxs param is enabled. Msgs wrapped in an authz MsgExec
+// are checked like top-level msgs, so a grant from a tagged granter is unusable.
+type TaggedAddressDecorator struct {
+	taggingKeeper keeper.Keeper
+}
+
+// NewTaggedAddressDecorator returns new tagged address decorator instance
+func NewTaggedAddressDecorator(taggingKeeper keeper.Keeper) TaggedAddressDecorator {
+	return TaggedAddressDecorator{
+		taggingKeeper: taggingKeeper,
+	}
+}
+
+func (tad TaggedAddressDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !tad.taggingKeeper.GetParams(ctx).RejectTaggedTxs {
+		return next(ctx, tx, simulate)
+	}
+
+	addrs := interactedAddresses(tx.GetMsgs())
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() != nil {
+		addrs = append(addrs, feeTx.FeeGranter())
+	}
+	for _, addr := range addrs {
+		if tad.taggingKeeper.IsTagged(ctx, addr) {
+			return ctx, sdkerrors.Wrapf(types.ErrTaggedTx, "%s", addr)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// interactedAddresses returns the signers of msgs along with any address they
+// send funds to, call into, migrate or make a contract admin, descending into
+// authz MsgExec. Signers cover the sender of IBC transfers and contract
+// instantiations. Malformed addresses are skipped since they are rejected by
+// ValidateBasic.
+func interactedAddresses(msgs []sdk.Msg) []sdk.AccAddress {
+	addrs := []sdk.AccAddress{}
+	for _, msg := range msgs {
+		addrs = append(addrs, msg.GetSigners()...)
+		recipients := []string{}
+		switch m := msg.(type) {
+		case *banktypes.MsgSend:
+			recipients = append(recipients, m.ToAddress)
+		case *banktypes.MsgMultiSend:
+			for _, output := range m.Outputs {
+				recipients = append(recipients, output.Address)
+			}
+		case *wasmtypes.MsgExecuteContract:
+			recipients = append(recipients, m.Contract)
+		case *wasmtypes.MsgInstantiateContract:
+			recipients = append(recipients, m.Admin)
+		case *wasmtypes.MsgMigrateContract:
+			recipients = append(recipients, m.Contract)
+		case *wasmtypes.MsgUpdateAdmin:
+			recipients = append(recipients, m.Contract, m.NewAdmin)
+		case *dextypes.MsgPlaceOrders:
+			// the order funds are sent to the contract
+			recipients = append(recipients, m.ContractAddr)
+		case *dextypes.MsgCancelOrders:
+			recipients = append(recipients, m.ContractAddr)
+		case *authz.MsgExec:
+			// inner msgs that fail to unpack are rejected by ValidateBasic
+			if inner, err := m.GetMessages(); err == nil {
+				addrs = append(addrs, interactedAddresses(inner)...)
+			}
+		}
+		for _, recipient := range recipients {
+			if addr, err := sdk.AccAddressFromBech32(recipient); err == nil {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	return addrs
+}
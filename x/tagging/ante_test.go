@@ -0,0 +1,106 @@
+package tagging_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/sei-protocol/sei-chain/app"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/tagging"
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestTaggedAddressAnteHandler(t *testing.T) {
+	testApp := app.Setup(false)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	tagged := sdk.AccAddress([]byte("tagged_address______"))
+	clean := sdk.AccAddress([]byte("clean_address_______"))
+	require.NoError(t, testApp.TaggingKeeper.SetAccountTag(ctx, types.AccountTag{Address: tagged.String(), Tag: "exploiter"}, types.AttributeValueSourceGov))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("usei", 1))
+	fromTagged := banktypes.NewMsgSend(tagged, clean, coins)
+	toTagged := banktypes.NewMsgSend(clean, tagged, coins)
+	multiToTagged := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(clean, coins)},
+		[]banktypes.Output{banktypes.NewOutput(tagged, coins)},
+	)
+	executeTagged := &wasmtypes.MsgExecuteContract{Sender: clean.String(), Contract: tagged.String()}
+	instantiateTaggedAdmin := &wasmtypes.MsgInstantiateContract{Sender: clean.String(), Admin: tagged.String(), CodeID: 1, Funds: coins}
+	instantiateFromTagged := &wasmtypes.MsgInstantiateContract{Sender: tagged.String(), CodeID: 1, Funds: coins}
+	migrateTagged := &wasmtypes.MsgMigrateContract{Sender: clean.String(), Contract: tagged.String(), CodeID: 2}
+	updateTaggedAdmin := &wasmtypes.MsgUpdateAdmin{Sender: clean.String(), Contract: tagged.String(), NewAdmin: clean.String()}
+	updateAdminToTagged := &wasmtypes.MsgUpdateAdmin{Sender: clean.String(), Contract: clean.String(), NewAdmin: tagged.String()}
+	placeOrdersOnTagged := dextypes.NewMsgPlaceOrders(clean.String(), []*dextypes.Order{}, tagged.String(), coins)
+	cancelOrdersOnTagged := dextypes.NewMsgCancelOrders(clean.String(), []*dextypes.Cancellation{}, tagged.String())
+	transferFromTagged := ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coins[0], tagged.String(), "cosmos1receiver", clienttypes.NewHeight(0, 100), 0)
+	cleanSend := banktypes.NewMsgSend(clean, clean, coins)
+
+	// a grantee executing on behalf of a tagged granter, or for a clean granter
+	// towards a tagged address
+	execFromTagged := authz.NewMsgExec(clean, []sdk.Msg{fromTagged})
+	execToTagged := authz.NewMsgExec(clean, []sdk.Msg{toTagged})
+	execTaggedContract := authz.NewMsgExec(clean, []sdk.Msg{executeTagged})
+	nestedExec := authz.NewMsgExec(clean, []sdk.Msg{&execFromTagged})
+	execClean := authz.NewMsgExec(clean, []sdk.Msg{cleanSend})
+
+	txBuilder := app.MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(cleanSend))
+	txBuilder.SetFeeGranter(tagged)
+	feeGrantedByTagged := txBuilder.GetTx()
+
+	anteHandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(tagging.NewTaggedAddressDecorator(testApp.TaggingKeeper)))
+	testCases := []struct {
+		name   string
+		expErr bool
+		tx     sdk.Tx
+	}{
+		{"signed by tagged", true, app.NewTestTx([]sdk.Msg{fromTagged})},
+		{"send to tagged", true, app.NewTestTx([]sdk.Msg{toTagged})},
+		{"multisend to tagged", true, app.NewTestTx([]sdk.Msg{multiToTagged})},
+		{"execute tagged contract", true, app.NewTestTx([]sdk.Msg{executeTagged})},
+		{"instantiate with tagged admin", true, app.NewTestTx([]sdk.Msg{instantiateTaggedAdmin})},
+		{"instantiate funded by tagged", true, app.NewTestTx([]sdk.Msg{instantiateFromTagged})},
+		{"migrate tagged contract", true, app.NewTestTx([]sdk.Msg{migrateTagged})},
+		{"update admin of tagged contract", true, app.NewTestTx([]sdk.Msg{updateTaggedAdmin})},
+		{"update admin to tagged", true, app.NewTestTx([]sdk.Msg{updateAdminToTagged})},
+		{"place orders on tagged contract", true, app.NewTestTx([]sdk.Msg{placeOrdersOnTagged})},
+		{"cancel orders on tagged contract", true, app.NewTestTx([]sdk.Msg{cancelOrdersOnTagged})},
+		{"ibc transfer from tagged", true, app.NewTestTx([]sdk.Msg{transferFromTagged})},
+		{"exec for tagged granter", true, app.NewTestTx([]sdk.Msg{&execFromTagged})},
+		{"exec send to tagged", true, app.NewTestTx([]sdk.Msg{&execToTagged})},
+		{"exec tagged contract", true, app.NewTestTx([]sdk.Msg{&execTaggedContract})},
+		{"nested exec for tagged granter", true, app.NewTestTx([]sdk.Msg{&nestedExec})},
+		{"fee granted by tagged", true, feeGrantedByTagged},
+		{"exec with no tagged address", false, app.NewTestTx([]sdk.Msg{&execClean})},
+		{"no tagged address", false, app.NewTestTx([]sdk.Msg{cleanSend})},
+	}
+
+	// nothing is rejected until the param is enabled
+	for _, tc := range testCases {
+		_, err := anteHandler(ctx, tc.tx, false)
+		require.NoError(t, err, tc.name)
+	}
+
+	params := testApp.TaggingKeeper.GetParams(ctx)
+	params.RejectTaggedTxs = true
+	testApp.TaggingKeeper.SetParams(ctx, params)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler(ctx, tc.tx, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrTaggedTx)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package tagging
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/keeper"
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func HandleSetAccountTagsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetAccountTagsProposal) error {
+	for _, tag := range p.Tags {
+		if err := k.SetAccountTag(ctx, tag, types.AttributeValueSourceGov); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func HandleRemoveAccountTagsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemoveAccountTagsProposal) error {
+	for _, address := range p.Addresses {
+		if err := k.RemoveAccountTag(ctx, address, types.AttributeValueSourceGov); err != nil {
+			return err
+		}
+	}
+	return nil
+}
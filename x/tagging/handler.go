@@ -0,0 +1,23 @@
+package tagging
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/keeper"
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetAccountTagsProposal:
+			return HandleSetAccountTagsProposal(ctx, &k, c)
+		case *types.RemoveAccountTagsProposal:
+			return HandleRemoveAccountTagsProposal(ctx, &k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized tagging proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+// InitGenesis initializes the tagging module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, tag := range genState.Tags {
+		addr, err := sdk.AccAddressFromBech32(tag.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.tagStore(ctx).Set(addr, k.cdc.MustMarshal(&tag))
+	}
+}
+
+// ExportGenesis returns the tagging module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Tags:   k.GetAllAccountTags(ctx),
+	}
+}
@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genState := types.GenesisState{
+		Params: types.Params{
+			Authority:       suite.TestAccs[0].String(),
+			RejectTaggedTxs: true,
+		},
+		Tags: []types.AccountTag{
+			{Address: suite.TestAccs[1].String(), Tag: "exploiter"},
+		},
+	}
+	suite.Require().NoError(genState.Validate())
+
+	suite.App.TaggingKeeper.InitGenesis(suite.Ctx, genState)
+	suite.Require().Equal(&genState, suite.App.TaggingKeeper.ExportGenesis(suite.Ctx))
+}
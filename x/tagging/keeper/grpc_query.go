@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: k.GetParams(sdkCtx)}, nil
+}
+
+func (k Keeper) AccountTag(ctx context.Context, req *types.QueryAccountTagRequest) (*types.QueryAccountTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tag, found := k.GetAccountTag(sdkCtx, addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrTagNotFound.Error())
+	}
+	return &types.QueryAccountTagResponse{Tag: tag}, nil
+}
+
+func (k Keeper) AccountTags(ctx context.Context, req *types.QueryAccountTagsRequest) (*types.QueryAccountTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tags := []types.AccountTag{}
+	pageRes, err := query.Paginate(k.tagStore(sdkCtx), req.Pagination, func(_ []byte, value []byte) error {
+		tag := types.AccountTag{}
+		if err := k.cdc.Unmarshal(value, &tag); err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountTagsResponse{Tags: tags, Pagination: pageRes}, nil
+}
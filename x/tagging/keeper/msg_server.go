@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) SetAccountTag(goCtx context.Context, msg *types.MsgSetAccountTag) (*types.MsgSetAccountTagResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.validateAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	tag := types.AccountTag{Address: msg.Address, Tag: msg.Tag}
+	if err := server.Keeper.SetAccountTag(ctx, tag, types.AttributeValueSourceAuthority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAccountTagResponse{}, nil
+}
+
+func (server msgServer) RemoveAccountTag(goCtx context.Context, msg *types.MsgRemoveAccountTag) (*types.MsgRemoveAccountTagResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.validateAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := server.Keeper.RemoveAccountTag(ctx, msg.Address, types.AttributeValueSourceAuthority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAccountTagResponse{}, nil
+}
+
+func (server msgServer) validateAuthority(ctx sdk.Context, signer string) error {
+	authority := server.GetParams(ctx).Authority
+	if authority == "" || authority != signer {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s", signer)
+	}
+	return nil
+}
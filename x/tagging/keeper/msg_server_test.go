@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/keeper"
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServerRequiresAuthority() {
+	server := keeper.NewMsgServerImpl(suite.App.TaggingKeeper)
+	wctx := sdk.WrapSDKContext(suite.Ctx)
+
+	authority := suite.TestAccs[0].String()
+	other := suite.TestAccs[1].String()
+	tagged := suite.TestAccs[2]
+
+	// no authority configured, only governance can tag
+	_, err := server.SetAccountTag(wctx, types.NewMsgSetAccountTag(authority, tagged.String(), "exploiter"))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	params := types.DefaultParams()
+	params.Authority = authority
+	suite.App.TaggingKeeper.SetParams(suite.Ctx, params)
+
+	_, err = server.SetAccountTag(wctx, types.NewMsgSetAccountTag(other, tagged.String(), "exploiter"))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = server.SetAccountTag(wctx, types.NewMsgSetAccountTag(authority, tagged.String(), "exploiter"))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.TaggingKeeper.IsTagged(suite.Ctx, tagged))
+
+	_, err = server.RemoveAccountTag(wctx, types.NewMsgRemoveAccountTag(other, tagged.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = server.RemoveAccountTag(wctx, types.NewMsgRemoveAccountTag(authority, tagged.String()))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.TaggingKeeper.IsTagged(suite.Ctx, tagged))
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+// SetAccountTag tags an address, replacing any existing tag, and emits an
+// event recording which source made the change
+func (k Keeper) SetAccountTag(ctx sdk.Context, tag types.AccountTag, source string) error {
+	addr, err := sdk.AccAddressFromBech32(tag.Address)
+	if err != nil {
+		return err
+	}
+	k.tagStore(ctx).Set(addr, k.cdc.MustMarshal(&tag))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAccountTag,
+			sdk.NewAttribute(types.AttributeKeyAddress, tag.Address),
+			sdk.NewAttribute(types.AttributeKeyTag, tag.Tag),
+			sdk.NewAttribute(types.AttributeKeySource, source),
+		),
+	)
+	return nil
+}
+
+// RemoveAccountTag untags an address and emits an event recording which
+// source made the change
+func (k Keeper) RemoveAccountTag(ctx sdk.Context, address string, source string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	store := k.tagStore(ctx)
+	if !store.Has(addr) {
+		return types.ErrTagNotFound
+	}
+	store.Delete(addr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAccountTag,
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeySource, source),
+		),
+	)
+	return nil
+}
+
+// GetAccountTag returns the tag of an address, if any
+func (k Keeper) GetAccountTag(ctx sdk.Context, addr sdk.AccAddress) (types.AccountTag, bool) {
+	bz := k.tagStore(ctx).Get(addr)
+	if bz == nil {
+		return types.AccountTag{}, false
+	}
+	tag := types.AccountTag{}
+	k.cdc.MustUnmarshal(bz, &tag)
+	return tag, true
+}
+
+// IsTagged returns whether an address carries any tag
+func (k Keeper) IsTagged(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.tagStore(ctx).Has(addr)
+}
+
+// GetAllAccountTags returns every tag in address order
+func (k Keeper) GetAllAccountTags(ctx sdk.Context) []types.AccountTag {
+	iterator := k.tagStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	tags := []types.AccountTag{}
+	for ; iterator.Valid(); iterator.Next() {
+		tag := types.AccountTag{}
+		k.cdc.MustUnmarshal(iterator.Value(), &tag)
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func (k Keeper) tagStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTagPrefix())
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAndRemoveAccountTag() {
+	k := suite.App.TaggingKeeper
+	addr := suite.TestAccs[0]
+
+	suite.Require().False(k.IsTagged(suite.Ctx, addr))
+	tag := types.AccountTag{Address: addr.String(), Tag: "exploiter"}
+	suite.Require().NoError(k.SetAccountTag(suite.Ctx, tag, types.AttributeValueSourceGov))
+	suite.Require().True(k.IsTagged(suite.Ctx, addr))
+	stored, found := k.GetAccountTag(suite.Ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(tag, stored)
+
+	events := suite.Ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeSetAccountTag, events[len(events)-1].Type)
+
+	// setting again replaces the tag
+	tag.Tag = "sanctioned"
+	suite.Require().NoError(k.SetAccountTag(suite.Ctx, tag, types.AttributeValueSourceGov))
+	suite.Require().Equal([]types.AccountTag{tag}, k.GetAllAccountTags(suite.Ctx))
+
+	suite.Require().NoError(k.RemoveAccountTag(suite.Ctx, addr.String(), types.AttributeValueSourceGov))
+	suite.Require().False(k.IsTagged(suite.Ctx, addr))
+	events = suite.Ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeRemoveAccountTag, events[len(events)-1].Type)
+
+	suite.Require().ErrorIs(k.RemoveAccountTag(suite.Ctx, addr.String(), types.AttributeValueSourceGov), types.ErrTagNotFound)
+}
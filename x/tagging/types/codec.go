@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetAccountTag{}, "tagging/MsgSetAccountTag", nil)
+	cdc.RegisterConcrete(&MsgRemoveAccountTag{}, "tagging/MsgRemoveAccountTag", nil)
+	cdc.RegisterConcrete(&SetAccountTagsProposal{}, "tagging/SetAccountTagsProposal", nil)
+	cdc.RegisterConcrete(&RemoveAccountTagsProposal{}, "tagging/RemoveAccountTagsProposal", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAccountTag{},
+		&MsgRemoveAccountTag{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SetAccountTagsProposal{},
+		&RemoveAccountTagsProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tagging module sentinel errors
+var (
+	ErrInvalidTag   = sdkerrors.Register(ModuleName, 2, "invalid tag")
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 3, "signer is not the tagging authority")
+	ErrTagNotFound  = sdkerrors.Register(ModuleName, 4, "address is not tagged")
+	ErrTaggedTx     = sdkerrors.Register(ModuleName, 5, "tx interacts with a tagged address")
+)
@@ -0,0 +1,14 @@
+package types
+
+// tagging module event types
+const (
+	EventTypeSetAccountTag    = "set_account_tag"
+	EventTypeRemoveAccountTag = "remove_account_tag"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyTag     = "tag"
+	AttributeKeySource  = "source"
+
+	AttributeValueSourceGov       = "gov"
+	AttributeValueSourceAuthority = "authority"
+)
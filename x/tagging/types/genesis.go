@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default tagging genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Tags:   []AccountTag{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range gs.Tags {
+		if err := tag.Validate(); err != nil {
+			return err
+		}
+		if seen[tag.Address] {
+			return fmt.Errorf("duplicate tag for address %s", tag.Address)
+		}
+		seen[tag.Address] = true
+	}
+
+	return nil
+}
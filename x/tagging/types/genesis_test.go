@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/tagging/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	address := sdk.AccAddress([]byte("tagged_address______")).String()
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid tags",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tags:   []types.AccountTag{{Address: address, Tag: "exploiter"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid authority",
+			genState: &types.GenesisState{
+				Params: types.Params{Authority: "sei1invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tags: []types.AccountTag{
+					{Address: address, Tag: "exploiter"},
+					{Address: address, Tag: "sanctioned"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty tag",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tags:   []types.AccountTag{{Address: address}},
+			},
+			valid: false,
+		},
+		{
+			desc: "tag too long",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Tags:   []types.AccountTag{{Address: address, Tag: strings.Repeat("a", types.MaxTagLength+1)}},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
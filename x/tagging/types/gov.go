@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetAccountTags    = "SetAccountTags"
+	ProposalTypeRemoveAccountTags = "RemoveAccountTags"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeSetAccountTags)
+	govtypes.RegisterProposalType(ProposalTypeRemoveAccountTags)
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&SetAccountTagsProposal{}, "tagging/SetAccountTagsProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveAccountTagsProposal{}, "tagging/RemoveAccountTagsProposal")
+}
+
+func (p *SetAccountTagsProposal) GetTitle() string { return p.Title }
+
+func (p *SetAccountTagsProposal) GetDescription() string { return p.Description }
+
+func (p *SetAccountTagsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetAccountTagsProposal) ProposalType() string {
+	return ProposalTypeSetAccountTags
+}
+
+func (p *SetAccountTagsProposal) ValidateBasic() error {
+	if len(p.Tags) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTag, "proposal contains no tags")
+	}
+	for _, tag := range p.Tags {
+		if err := tag.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p SetAccountTagsProposal) String() string {
+	tags := ""
+	for _, tag := range p.Tags {
+		tags += fmt.Sprintf("%s=%s ", tag.Address, tag.Tag)
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Account Tags Proposal:
+  Title:       %s
+  Description: %s
+  Tags:        %s
+`, p.Title, p.Description, tags))
+	return b.String()
+}
+
+func (p *RemoveAccountTagsProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveAccountTagsProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveAccountTagsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveAccountTagsProposal) ProposalType() string {
+	return ProposalTypeRemoveAccountTags
+}
+
+func (p *RemoveAccountTagsProposal) ValidateBasic() error {
+	if len(p.Addresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal contains no addresses")
+	}
+	for _, address := range p.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tagged address (%s)", err)
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p RemoveAccountTagsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Account Tags Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, p.Title, p.Description, strings.Join(p.Addresses, " ")))
+	return b.String()
+}
@@ -0,0 +1,26 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tagging"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the tagging module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+const (
+	AccountTagKey = "account-tag-"
+	MaxTagLength  = 64
+)
+
+// AccountTagPrefix returns the store prefix under which tags are keyed by
+// address bytes
+func AccountTagPrefix() []byte {
+	return []byte(AccountTagKey)
+}
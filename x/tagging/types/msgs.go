@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetAccountTag    = "set_account_tag"
+	TypeMsgRemoveAccountTag = "remove_account_tag"
+)
+
+var (
+	_ sdk.Msg = &MsgSetAccountTag{}
+	_ sdk.Msg = &MsgRemoveAccountTag{}
+)
+
+// NewMsgSetAccountTag creates a msg to tag an address
+func NewMsgSetAccountTag(authority, address, tag string) *MsgSetAccountTag {
+	return &MsgSetAccountTag{
+		Authority: authority,
+		Address:   address,
+		Tag:       tag,
+	}
+}
+
+func (m MsgSetAccountTag) Route() string { return RouterKey }
+func (m MsgSetAccountTag) Type() string  { return TypeMsgSetAccountTag }
+func (m MsgSetAccountTag) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return AccountTag{Address: m.Address, Tag: m.Tag}.Validate()
+}
+
+func (m MsgSetAccountTag) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAccountTag) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRemoveAccountTag creates a msg to untag an address
+func NewMsgRemoveAccountTag(authority, address string) *MsgRemoveAccountTag {
+	return &MsgRemoveAccountTag{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (m MsgRemoveAccountTag) Route() string { return RouterKey }
+func (m MsgRemoveAccountTag) Type() string  { return TypeMsgRemoveAccountTag }
+func (m MsgRemoveAccountTag) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid tagged address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRemoveAccountTag) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveAccountTag) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
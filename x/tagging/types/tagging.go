@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateTag checks that a tag is non-empty and at most MaxTagLength bytes
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return sdkerrors.Wrap(ErrInvalidTag, "tag is empty")
+	}
+	if len(tag) > MaxTagLength {
+		return sdkerrors.Wrapf(ErrInvalidTag, "tag is longer than %d bytes", MaxTagLength)
+	}
+	return nil
+}
+
+// Validate checks that the tagged address and the tag are well formed
+func (t AccountTag) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tagged address (%s)", err)
+	}
+	return ValidateTag(t.Tag)
+}
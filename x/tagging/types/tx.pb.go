@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tagging/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetAccountTag tags an address. It must be signed by the authority param.
+type MsgSetAccountTag struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Tag       string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty" yaml:"tag"`
+}
+
+func (m *MsgSetAccountTag) Reset()         { *m = MsgSetAccountTag{} }
+func (m *MsgSetAccountTag) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountTag) ProtoMessage()    {}
+func (*MsgSetAccountTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eea0df637401d391, []int{0}
+}
+func (m *MsgSetAccountTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountTag.Merge(m, src)
+}
+func (m *MsgSetAccountTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountTag proto.InternalMessageInfo
+
+func (m *MsgSetAccountTag) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAccountTag) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetAccountTag) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type MsgSetAccountTagResponse struct {
+}
+
+func (m *MsgSetAccountTagResponse) Reset()         { *m = MsgSetAccountTagResponse{} }
+func (m *MsgSetAccountTagResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountTagResponse) ProtoMessage()    {}
+func (*MsgSetAccountTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eea0df637401d391, []int{1}
+}
+func (m *MsgSetAccountTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountTagResponse.Merge(m, src)
+}
+func (m *MsgSetAccountTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountTagResponse proto.InternalMessageInfo
+
+// MsgRemoveAccountTag untags an address. It must be signed by the authority
+// param.
+type MsgRemoveAccountTag struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *MsgRemoveAccountTag) Reset()         { *m = MsgRemoveAccountTag{} }
+func (m *MsgRemoveAccountTag) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAccountTag) ProtoMessage()    {}
+func (*MsgRemoveAccountTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eea0df637401d391, []int{2}
+}
+func (m *MsgRemoveAccountTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAccountTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAccountTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAccountTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAccountTag.Merge(m, src)
+}
+func (m *MsgRemoveAccountTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAccountTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAccountTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAccountTag proto.InternalMessageInfo
+
+func (m *MsgRemoveAccountTag) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveAccountTag) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type MsgRemoveAccountTagResponse struct {
+}
+
+func (m *MsgRemoveAccountTagResponse) Reset()         { *m = MsgRemoveAccountTagResponse{} }
+func (m *MsgRemoveAccountTagResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAccountTagResponse) ProtoMessage()    {}
+func (*MsgRemoveAccountTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eea0df637401d391, []int{3}
+}
+func (m *MsgRemoveAccountTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAccountTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAccountTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAccountTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAccountTagResponse.Merge(m, src)
+}
+func (m *MsgRemoveAccountTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAccountTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAccountTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAccountTagResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAccountTag)(nil), "seiprotocol.seichain.tagging.MsgSetAccountTag")
+	proto.RegisterType((*MsgSetAccountTagResponse)(nil), "seiprotocol.seichain.tagging.MsgSetAccountTagResponse")
+	proto.RegisterType((*MsgRemoveAccountTag)(nil), "seiprotocol.seichain.tagging.MsgRemoveAccountTag")
+	proto.RegisterType((*MsgRemoveAccountTagResponse)(nil), "seiprotocol.seichain.tagging.MsgRemoveAccountTagResponse")
+}
+
+func init() { proto.RegisterFile("tagging/tx.proto", fileDescriptor_eea0df637401d391) }
+
+var fileDescriptor_eea0df637401d391 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x4f, 0x3a, 0x31,
+	0x18, 0xc6, 0x29, 0x24, 0xff, 0x7f, 0x68, 0x22, 0xb9, 0x54, 0x86, 0xcb, 0xa9, 0x85, 0x74, 0x72,
+	0xd0, 0x5e, 0xc0, 0xc4, 0x44, 0x37, 0x59, 0x0d, 0xcb, 0xe9, 0xe4, 0x56, 0x8e, 0xe6, 0xa5, 0x09,
+	0x5c, 0x09, 0x2d, 0x02, 0x9b, 0x7e, 0x03, 0x27, 0x3f, 0x93, 0x23, 0xa3, 0x13, 0x31, 0xf0, 0x0d,
+	0xf8, 0x04, 0x86, 0xc2, 0x61, 0x3c, 0x8d, 0x86, 0xc5, 0xed, 0x6d, 0x9e, 0xdf, 0xf3, 0xbe, 0x4f,
+	0xdf, 0x16, 0x7b, 0x56, 0x00, 0xa8, 0x04, 0x42, 0x3b, 0xe6, 0xfd, 0x81, 0xb6, 0x9a, 0x1c, 0x1a,
+	0xa9, 0x5c, 0x15, 0xeb, 0x2e, 0x37, 0x52, 0xc5, 0x1d, 0xa1, 0x12, 0xbe, 0xc1, 0x82, 0x32, 0x68,
+	0xd0, 0x4e, 0x0e, 0x57, 0xd5, 0xda, 0xc3, 0x9e, 0x11, 0xf6, 0x9a, 0x06, 0x6e, 0xa4, 0xbd, 0x8a,
+	0x63, 0x3d, 0x4c, 0xec, 0xad, 0x00, 0x52, 0xc7, 0x45, 0x31, 0xb4, 0x1d, 0x3d, 0x50, 0x76, 0xe2,
+	0xa3, 0x2a, 0x3a, 0x2e, 0x36, 0xca, 0xcb, 0x59, 0xc5, 0x9b, 0x88, 0x5e, 0xf7, 0x92, 0x6d, 0x25,
+	0x16, 0x7d, 0x60, 0xe4, 0x04, 0xff, 0x17, 0xed, 0xf6, 0x40, 0x1a, 0xe3, 0xe7, 0x9d, 0x83, 0x2c,
+	0x67, 0x95, 0xd2, 0xc6, 0xb1, 0x16, 0x58, 0x94, 0x22, 0xa4, 0x8a, 0x0b, 0x56, 0x80, 0x5f, 0x70,
+	0x64, 0x69, 0x39, 0xab, 0xe0, 0x35, 0x69, 0x05, 0xb0, 0x68, 0x25, 0xb1, 0x00, 0xfb, 0xd9, 0x5c,
+	0x91, 0x34, 0x7d, 0x9d, 0x18, 0xc9, 0x46, 0x78, 0xbf, 0x69, 0x20, 0x92, 0x3d, 0x7d, 0x2f, 0xff,
+	0x32, 0x36, 0x3b, 0xc2, 0x07, 0xdf, 0x0c, 0x4e, 0x73, 0xd5, 0x1f, 0xf3, 0xb8, 0xd0, 0x34, 0x40,
+	0x46, 0x78, 0xef, 0xf3, 0x42, 0x39, 0xff, 0xe9, 0x69, 0x78, 0xf6, 0xa2, 0xc1, 0xf9, 0x6e, 0x7c,
+	0x1a, 0x80, 0x3c, 0x20, 0xec, 0x7d, 0x59, 0x4b, 0xed, 0xd7, 0x66, 0x59, 0x4b, 0x70, 0xb1, 0xb3,
+	0x25, 0x8d, 0xd0, 0xb8, 0x7e, 0x99, 0x53, 0x34, 0x9d, 0x53, 0xf4, 0x36, 0xa7, 0xe8, 0x69, 0x41,
+	0x73, 0xd3, 0x05, 0xcd, 0xbd, 0x2e, 0x68, 0xee, 0xae, 0x06, 0xca, 0x76, 0x86, 0x2d, 0x1e, 0xeb,
+	0x5e, 0x68, 0xa4, 0x3a, 0x4d, 0xfb, 0xbb, 0x83, 0x1b, 0x10, 0x8e, 0xc3, 0xed, 0xa7, 0x9e, 0xf4,
+	0xa5, 0x69, 0xfd, 0x73, 0xcc, 0xd9, 0xfb, 0x00, 0x46, 0xd5, 0x06, 0xbb, 0xec, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetAccountTag(ctx context.Context, in *MsgSetAccountTag, opts ...grpc.CallOption) (*MsgSetAccountTagResponse, error)
+	RemoveAccountTag(ctx context.Context, in *MsgRemoveAccountTag, opts ...grpc.CallOption) (*MsgRemoveAccountTagResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAccountTag(ctx context.Context, in *MsgSetAccountTag, opts ...grpc.CallOption) (*MsgSetAccountTagResponse, error) {
+	out := new(MsgSetAccountTagResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.tagging.Msg/SetAccountTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAccountTag(ctx context.Context, in *MsgRemoveAccountTag, opts ...grpc.CallOption) (*MsgRemoveAccountTagResponse, error) {
+	out := new(MsgRemoveAccountTagResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.tagging.Msg/RemoveAccountTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetAccountTag(context.Context, *MsgSetAccountTag) (*MsgSetAccountTagResponse, error)
+	RemoveAccountTag(context.Context, *MsgRemoveAccountTag) (*MsgRemoveAccountTagResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAccountTag(ctx context.Context, req *MsgSetAccountTag) (*MsgSetAccountTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountTag not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAccountTag(ctx context.Context, req *MsgRemoveAccountTag) (*MsgRemoveAccountTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountTag not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAccountTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.tagging.Msg/SetAccountTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountTag(ctx, req.(*MsgSetAccountTag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAccountTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAccountTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAccountTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.tagging.Msg/RemoveAccountTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAccountTag(ctx, req.(*MsgRemoveAccountTag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.tagging.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAccountTag",
+			Handler:    _Msg_SetAccountTag_Handler,
+		},
+		{
+			MethodName: "RemoveAccountTag",
+			Handler:    _Msg_RemoveAccountTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tagging/tx.proto",
+}
+
+func (m *MsgSetAccountTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAccountTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAccountTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAccountTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAccountTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAccountTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAccountTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAccountTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAccountTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAccountTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAccountTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAccountTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAccountTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAccountTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAccountTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAccountTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAccountTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAccountTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
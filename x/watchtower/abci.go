@@ -0,0 +1,19 @@
+package watchtower
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/keeper"
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+// EndBlocker checks every balance alert against the balances left by this
+// block's txs.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.CheckBalanceAlerts(ctx)
+}
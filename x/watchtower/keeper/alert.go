@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+// RegisterBalanceAlert registers, or replaces, the owner's alert for a denom
+// with the owner's current balance as its baseline. A new alert escrows the
+// alert deposit from the owner; a replaced alert keeps its deposit.
+func (k Keeper) RegisterBalanceAlert(ctx sdk.Context, owner sdk.AccAddress, denom string, dropThreshold sdk.Dec) error {
+	params := k.GetParams(ctx)
+	deposit, found := k.getBalanceAlertDeposit(ctx, owner, denom)
+	if !found {
+		if uint32(len(k.GetBalanceAlerts(ctx, owner))) >= params.MaxAlertsPerAccount {
+			return sdkerrors.Wrapf(types.ErrTooManyAlerts, "%s already has %d alerts", owner, params.MaxAlertsPerAccount)
+		}
+		deposit = params.AlertDeposit
+		if deposit.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+				return err
+			}
+		}
+	}
+
+	baseline := k.bankKeeper.GetBalance(ctx, owner, denom).Amount
+	alert := types.NewBalanceAlert(owner.String(), denom, dropThreshold, baseline, deposit)
+	k.setBalanceAlert(ctx, alert)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterBalanceAlert,
+			sdk.NewAttribute(types.AttributeKeyOwner, alert.Owner),
+			sdk.NewAttribute(types.AttributeKeyDenom, alert.Denom),
+			sdk.NewAttribute(types.AttributeKeyDropThreshold, alert.DropThreshold.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseline, alert.Baseline.String()),
+		),
+	)
+	return nil
+}
+
+// RemoveBalanceAlert removes the owner's alert for a denom and refunds its
+// deposit
+func (k Keeper) RemoveBalanceAlert(ctx sdk.Context, owner sdk.AccAddress, denom string) error {
+	deposit, found := k.getBalanceAlertDeposit(ctx, owner, denom)
+	if !found {
+		return types.ErrAlertNotFound
+	}
+	k.alertStore(ctx).Delete(types.BalanceAlertKeySuffix(owner, denom))
+	if deposit.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(deposit)); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveBalanceAlert,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+	return nil
+}
+
+// GetBalanceAlerts returns the alerts registered by an owner in denom order
+func (k Keeper) GetBalanceAlerts(ctx sdk.Context, owner sdk.AccAddress) []types.BalanceAlert {
+	store := prefix.NewStore(k.alertStore(ctx), types.OwnerAlertPrefix(owner))
+	return k.collectAlerts(store)
+}
+
+// GetAllBalanceAlerts returns every registered alert
+func (k Keeper) GetAllBalanceAlerts(ctx sdk.Context) []types.BalanceAlert {
+	return k.collectAlerts(k.alertStore(ctx))
+}
+
+// CheckBalanceAlerts compares up to max_checks_per_block alerts' baselines
+// with the owners' current balances, starting where the previous block
+// stopped. A drop of at least the threshold emits a balance_drop_alert event
+// and resets the baseline to the new balance, so each drop fires once. A rise
+// moves the baseline up, so the threshold is measured from the high point.
+func (k Keeper) CheckBalanceAlerts(ctx sdk.Context) {
+	alerts, next := k.alertsToCheck(ctx, k.getCheckCursor(ctx), k.GetParams(ctx).MaxChecksPerBlock)
+	for _, alert := range alerts {
+		owner := sdk.MustAccAddressFromBech32(alert.Owner)
+		balance := k.bankKeeper.GetBalance(ctx, owner, alert.Denom).Amount
+
+		if alert.IsTriggered(balance) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBalanceDropAlert,
+					sdk.NewAttribute(types.AttributeKeyOwner, alert.Owner),
+					sdk.NewAttribute(types.AttributeKeyDenom, alert.Denom),
+					sdk.NewAttribute(types.AttributeKeyDropThreshold, alert.DropThreshold.String()),
+					sdk.NewAttribute(types.AttributeKeyBaseline, alert.Baseline.String()),
+					sdk.NewAttribute(types.AttributeKeyBalance, balance.String()),
+				),
+			)
+		} else if balance.LTE(alert.Baseline) {
+			continue
+		}
+		alert.Baseline = balance
+		k.setBalanceAlert(ctx, alert)
+	}
+	k.setCheckCursor(ctx, next)
+}
+
+// alertsToCheck returns up to limit alerts starting from cursor and wrapping
+// around to the first alert, along with the key to start from next time. A
+// nil key means the next check starts from the first alert.
+func (k Keeper) alertsToCheck(ctx sdk.Context, cursor []byte, limit uint64) ([]types.BalanceAlert, []byte) {
+	store := k.alertStore(ctx)
+	alerts := []types.BalanceAlert{}
+	var next []byte
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			if uint64(len(alerts)) >= limit {
+				next = append([]byte{}, iterator.Key()...)
+				return
+			}
+			alert := types.BalanceAlert{}
+			k.cdc.MustUnmarshal(iterator.Value(), &alert)
+			alerts = append(alerts, alert)
+		}
+	}
+
+	collect(cursor, nil)
+	if next == nil && cursor != nil {
+		collect(nil, cursor)
+	}
+	return alerts, next
+}
+
+func (k Keeper) getCheckCursor(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get([]byte(types.CheckCursorKey))
+}
+
+func (k Keeper) setCheckCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if cursor == nil {
+		store.Delete([]byte(types.CheckCursorKey))
+		return
+	}
+	store.Set([]byte(types.CheckCursorKey), cursor)
+}
+
+func (k Keeper) getBalanceAlertDeposit(ctx sdk.Context, owner sdk.AccAddress, denom string) (sdk.Coin, bool) {
+	bz := k.alertStore(ctx).Get(types.BalanceAlertKeySuffix(owner, denom))
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+	alert := types.BalanceAlert{}
+	k.cdc.MustUnmarshal(bz, &alert)
+	return alert.Deposit, true
+}
+
+func (k Keeper) setBalanceAlert(ctx sdk.Context, alert types.BalanceAlert) {
+	owner := sdk.MustAccAddressFromBech32(alert.Owner)
+	k.alertStore(ctx).Set(types.BalanceAlertKeySuffix(owner, alert.Denom), k.cdc.MustMarshal(&alert))
+}
+
+func (k Keeper) collectAlerts(store prefix.Store) []types.BalanceAlert {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	alerts := []types.BalanceAlert{}
+	for ; iterator.Valid(); iterator.Next() {
+		alert := types.BalanceAlert{}
+		k.cdc.MustUnmarshal(iterator.Value(), &alert)
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+func (k Keeper) alertStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceAlertPrefix())
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/app/apptesting"
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckBalanceAlerts() {
+	k := suite.App.WatchtowerKeeper
+	owner, other := suite.TestAccs[0], suite.TestAccs[1]
+	suite.fundDeposits(1, owner)
+	suite.FundAcc(owner, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", sdk.MustNewDecFromStr("0.2")))
+	alerts := k.GetBalanceAlerts(suite.Ctx, owner)
+	suite.Require().Len(alerts, 1)
+	suite.Require().Equal(sdk.NewInt(1000), alerts[0].Baseline)
+
+	// a 10% drop is below the threshold
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, owner, other, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))))
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(0, suite.countDropAlerts())
+
+	// another 10% adds up to 20% of the baseline and fires once
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, owner, other, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))))
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(1, suite.countDropAlerts())
+	suite.Require().Equal(sdk.NewInt(800), k.GetBalanceAlerts(suite.Ctx, owner)[0].Baseline)
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(1, suite.countDropAlerts())
+
+	// deposits raise the baseline
+	suite.FundAcc(owner, sdk.NewCoins(sdk.NewInt64Coin("uatom", 200)))
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(sdk.NewInt(1000), k.GetBalanceAlerts(suite.Ctx, owner)[0].Baseline)
+
+	suite.Require().NoError(k.RemoveBalanceAlert(suite.Ctx, owner, "uatom"))
+	suite.Require().Empty(k.GetBalanceAlerts(suite.Ctx, owner))
+	suite.Require().ErrorIs(k.RemoveBalanceAlert(suite.Ctx, owner, "uatom"), types.ErrAlertNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCheckBalanceAlertsPerBlockLimit() {
+	k := suite.App.WatchtowerKeeper
+	params := k.GetParams(suite.Ctx)
+	params.MaxChecksPerBlock = 2
+	k.SetParams(suite.Ctx, params)
+	sink := suite.TestAccs[0]
+	owners := apptesting.CreateRandomAccounts(3)
+	for _, owner := range owners {
+		suite.fundDeposits(1, owner)
+		suite.FundAcc(owner, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+		suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", sdk.MustNewDecFromStr("0.5")))
+		suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, owner, sink, sdk.NewCoins(sdk.NewInt64Coin("uatom", 600))))
+	}
+
+	// two alerts are checked in the first block and the third in the next
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(2, suite.countDropAlerts())
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(3, suite.countDropAlerts())
+
+	// the check wraps around, so every alert keeps being checked
+	for _, owner := range owners {
+		suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, owner, sink, sdk.NewCoins(sdk.NewInt64Coin("uatom", 300))))
+	}
+	k.CheckBalanceAlerts(suite.Ctx)
+	k.CheckBalanceAlerts(suite.Ctx)
+	suite.Require().Equal(6, suite.countDropAlerts())
+}
+
+func (suite *KeeperTestSuite) TestAlertDeposit() {
+	k := suite.App.WatchtowerKeeper
+	deposit := k.GetParams(suite.Ctx).AlertDeposit
+	owner := suite.TestAccs[0]
+	threshold := sdk.MustNewDecFromStr("0.5")
+
+	// the owner can't register an alert without the deposit
+	suite.Require().ErrorIs(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", threshold), sdkerrors.ErrInsufficientFunds)
+
+	suite.fundDeposits(1, owner)
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", threshold))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, owner, deposit.Denom).IsZero())
+	suite.Require().Equal(deposit, k.GetBalanceAlerts(suite.Ctx, owner)[0].Deposit)
+
+	// replacing the alert keeps the escrowed deposit
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", sdk.MustNewDecFromStr("0.1")))
+	suite.Require().Equal(deposit, k.GetBalanceAlerts(suite.Ctx, owner)[0].Deposit)
+
+	// the deposit paid is refunded even if the param changed since
+	params := k.GetParams(suite.Ctx)
+	params.AlertDeposit = sdk.NewCoin(deposit.Denom, deposit.Amount.MulRaw(2))
+	k.SetParams(suite.Ctx, params)
+	suite.Require().NoError(k.RemoveBalanceAlert(suite.Ctx, owner, "uatom"))
+	suite.Require().Equal(deposit, suite.App.BankKeeper.GetBalance(suite.Ctx, owner, deposit.Denom))
+}
+
+func (suite *KeeperTestSuite) TestRegisterBalanceAlertLimits() {
+	k := suite.App.WatchtowerKeeper
+	params := k.GetParams(suite.Ctx)
+	params.MaxAlertsPerAccount = 1
+	k.SetParams(suite.Ctx, params)
+	threshold := sdk.MustNewDecFromStr("0.5")
+	owner := suite.TestAccs[0]
+	suite.fundDeposits(2, owner)
+
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "usei", threshold))
+	// replacing an existing alert does not count against the limit
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, owner, "usei", sdk.MustNewDecFromStr("0.1")))
+	suite.Require().ErrorIs(k.RegisterBalanceAlert(suite.Ctx, owner, "uatom", threshold), types.ErrTooManyAlerts)
+}
+
+// Filling many accounts with alerts costs a deposit per alert and doesn't stop
+// anyone else from registering
+func (suite *KeeperTestSuite) TestRegisterBalanceAlertNoLockout() {
+	k := suite.App.WatchtowerKeeper
+	params := k.GetParams(suite.Ctx)
+	threshold := sdk.MustNewDecFromStr("0.5")
+	denoms := []string{"uatom", "uosmo", "uusdc", "ueth", "ubtc", "usei"}
+
+	spammers := apptesting.CreateRandomAccounts(20)
+	escrowed := sdk.ZeroInt()
+	for _, spammer := range spammers {
+		suite.fundDeposits(int64(params.MaxAlertsPerAccount), spammer)
+		for _, denom := range denoms[:params.MaxAlertsPerAccount] {
+			suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, spammer, denom, threshold))
+			escrowed = escrowed.Add(params.AlertDeposit.Amount)
+		}
+		suite.Require().ErrorIs(k.RegisterBalanceAlert(suite.Ctx, spammer, denoms[params.MaxAlertsPerAccount], threshold), types.ErrTooManyAlerts)
+	}
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(escrowed, suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, params.AlertDeposit.Denom).Amount)
+
+	user := suite.TestAccs[0]
+	suite.fundDeposits(1, user)
+	suite.Require().NoError(k.RegisterBalanceAlert(suite.Ctx, user, "uatom", threshold))
+	suite.Require().Len(k.GetAllBalanceAlerts(suite.Ctx), len(spammers)*int(params.MaxAlertsPerAccount)+1)
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+// InitGenesis initializes the watchtower module's state from a provided
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.CreateModuleAccount(ctx)
+	k.SetParams(ctx, genState.Params)
+	for _, alert := range genState.Alerts {
+		k.setBalanceAlert(ctx, alert)
+	}
+}
+
+// ExportGenesis returns the watchtower module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Alerts: k.GetAllBalanceAlerts(ctx),
+	}
+}
@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Alerts: []types.BalanceAlert{
+			types.NewBalanceAlert(suite.TestAccs[0].String(), "usei", sdk.MustNewDecFromStr("0.2"), sdk.NewInt(1000), types.DefaultAlertDeposit),
+		},
+	}
+	suite.Require().NoError(genState.Validate())
+
+	suite.App.WatchtowerKeeper.InitGenesis(suite.Ctx, genState)
+	suite.Require().Equal(&genState, suite.App.WatchtowerKeeper.ExportGenesis(suite.Ctx))
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sei-protocol/sei-chain/app/apptesting"
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+// fundDeposits gives each address enough to escrow n alert deposits
+func (suite *KeeperTestSuite) fundDeposits(n int64, addrs ...sdk.AccAddress) {
+	deposit := suite.App.WatchtowerKeeper.GetParams(suite.Ctx).AlertDeposit
+	for _, addr := range addrs {
+		suite.FundAcc(addr, sdk.NewCoins(sdk.NewCoin(deposit.Denom, deposit.Amount.MulRaw(n))))
+	}
+}
+
+func (suite *KeeperTestSuite) countDropAlerts() int {
+	count := 0
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBalanceDropAlert {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) RegisterBalanceAlert(goCtx context.Context, msg *types.MsgRegisterBalanceAlert) (*types.MsgRegisterBalanceAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.Keeper.RegisterBalanceAlert(ctx, owner, msg.Denom, msg.DropThreshold); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterBalanceAlertResponse{}, nil
+}
+
+func (server msgServer) RemoveBalanceAlert(goCtx context.Context, msg *types.MsgRemoveBalanceAlert) (*types.MsgRemoveBalanceAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.Keeper.RemoveBalanceAlert(ctx, owner, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveBalanceAlertResponse{}, nil
+}
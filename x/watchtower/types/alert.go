@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewBalanceAlert returns an alert whose baseline is the given balance
+func NewBalanceAlert(owner, denom string, dropThreshold sdk.Dec, baseline sdk.Int, deposit sdk.Coin) BalanceAlert {
+	return BalanceAlert{
+		Owner:         owner,
+		Denom:         denom,
+		DropThreshold: dropThreshold,
+		Baseline:      baseline,
+		Deposit:       deposit,
+	}
+}
+
+// Validate checks that the alert has a valid owner, denom, threshold and
+// deposit
+func (a BalanceAlert) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if err := ValidateDropThreshold(a.DropThreshold); err != nil {
+		return err
+	}
+	if a.Baseline.IsNil() || a.Baseline.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "baseline must not be negative")
+	}
+	return a.Deposit.Validate()
+}
+
+// IsTriggered returns whether the balance has dropped by at least the
+// threshold from the baseline
+func (a BalanceAlert) IsTriggered(balance sdk.Int) bool {
+	if !a.Baseline.IsPositive() || balance.GTE(a.Baseline) {
+		return false
+	}
+	drop := a.Baseline.Sub(balance).ToDec().Quo(a.Baseline.ToDec())
+	return drop.GTE(a.DropThreshold)
+}
+
+// ValidateDropThreshold checks that a threshold is strictly between 0 and 1
+func ValidateDropThreshold(threshold sdk.Dec) error {
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GTE(sdk.OneDec()) {
+		return ErrInvalidThreshold
+	}
+	return nil
+}
@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: watchtower/alert.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BalanceAlert fires when the owner's balance of denom falls by at least
+// drop_threshold (a fraction between 0 and 1) below baseline.
+type BalanceAlert struct {
+	Owner         string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Denom         string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	DropThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=drop_threshold,json=dropThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drop_threshold" yaml:"drop_threshold"`
+	// baseline is the highest balance seen since registration or since the
+	// alert last fired.
+	Baseline github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=baseline,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"baseline" yaml:"baseline"`
+	// deposit is the amount escrowed when the alert was registered, refunded on
+	// removal.
+	Deposit types.Coin `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit" yaml:"deposit"`
+}
+
+func (m *BalanceAlert) Reset()         { *m = BalanceAlert{} }
+func (m *BalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*BalanceAlert) ProtoMessage()    {}
+func (*BalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_272ba0f3f1d33d82, []int{0}
+}
+func (m *BalanceAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceAlert.Merge(m, src)
+}
+func (m *BalanceAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceAlert proto.InternalMessageInfo
+
+func (m *BalanceAlert) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *BalanceAlert) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BalanceAlert) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*BalanceAlert)(nil), "seiprotocol.seichain.watchtower.BalanceAlert")
+}
+
+func init() { proto.RegisterFile("watchtower/alert.proto", fileDescriptor_272ba0f3f1d33d82) }
+
+var fileDescriptor_272ba0f3f1d33d82 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x6f, 0xdb, 0x30,
+	0x10, 0x95, 0xdc, 0xba, 0x1f, 0xaa, 0xeb, 0x16, 0x42, 0x6b, 0xa8, 0x1e, 0x24, 0x43, 0x83, 0xe1,
+	0xc5, 0x24, 0xdc, 0x76, 0xea, 0x66, 0xb5, 0x40, 0x51, 0x14, 0xe8, 0x20, 0x74, 0x0a, 0x10, 0x04,
+	0x14, 0x45, 0x58, 0x44, 0x24, 0x9e, 0x20, 0x32, 0x71, 0xfc, 0x2f, 0xf2, 0xb3, 0x3c, 0x7a, 0x0c,
+	0x32, 0x08, 0x81, 0xfd, 0x0f, 0x34, 0x66, 0x0a, 0x44, 0x59, 0x89, 0x93, 0x2d, 0x93, 0xee, 0xe3,
+	0xdd, 0x7b, 0x4f, 0x77, 0xb4, 0x06, 0x4b, 0xa2, 0x68, 0xa2, 0x60, 0xc9, 0x0a, 0x4c, 0x52, 0x56,
+	0x28, 0x94, 0x17, 0xa0, 0xc0, 0xf6, 0x24, 0xe3, 0x3a, 0xa2, 0x90, 0x22, 0xc9, 0x38, 0x4d, 0x08,
+	0x17, 0xe8, 0x01, 0x3c, 0xfc, 0xb4, 0x80, 0x05, 0x68, 0x04, 0xae, 0xa3, 0x66, 0x6c, 0xe8, 0x52,
+	0x90, 0x19, 0x48, 0x1c, 0x11, 0xc9, 0xf0, 0xf9, 0x2c, 0x62, 0x8a, 0xcc, 0x30, 0x05, 0x2e, 0x9a,
+	0xbe, 0x7f, 0xdb, 0xb1, 0x7a, 0x01, 0x49, 0x89, 0xa0, 0x6c, 0x5e, 0xab, 0xd9, 0x63, 0xab, 0x0b,
+	0x4b, 0xc1, 0x0a, 0xc7, 0x1c, 0x99, 0x93, 0xb7, 0xc1, 0xc7, 0xaa, 0xf4, 0x7a, 0x2b, 0x92, 0xa5,
+	0x3f, 0x7c, 0x5d, 0xf6, 0xc3, 0xa6, 0x5d, 0xe3, 0x62, 0x26, 0x20, 0x73, 0x3a, 0x4f, 0x71, 0xba,
+	0xec, 0x87, 0x4d, 0xdb, 0x16, 0x56, 0x3f, 0x2e, 0x20, 0x3f, 0x51, 0x49, 0xc1, 0x64, 0x02, 0x69,
+	0xec, 0xbc, 0xd0, 0x03, 0xbf, 0xd7, 0xa5, 0x67, 0x5c, 0x97, 0xde, 0x78, 0xc1, 0x55, 0x72, 0x16,
+	0x21, 0x0a, 0x19, 0xde, 0x7b, 0x6d, 0x3e, 0x53, 0x19, 0x9f, 0x62, 0xb5, 0xca, 0x99, 0x44, 0xbf,
+	0x18, 0xad, 0x4a, 0xef, 0xf3, 0x9e, 0xfe, 0x11, 0x9b, 0x1f, 0xbe, 0xaf, 0x0b, 0xff, 0xdb, 0xdc,
+	0x3e, 0xb6, 0xde, 0xd4, 0xff, 0x9a, 0x72, 0xc1, 0x9c, 0x97, 0x5a, 0x69, 0xfe, 0x0c, 0xa5, 0x3f,
+	0x42, 0x55, 0xa5, 0xf7, 0xa1, 0x51, 0x6a, 0x79, 0xfc, 0xf0, 0x9e, 0xd2, 0xfe, 0x6b, 0xbd, 0x8e,
+	0x59, 0x0e, 0x92, 0x2b, 0xa7, 0x3b, 0x32, 0x27, 0xef, 0xbe, 0x7e, 0x41, 0x0d, 0x09, 0xaa, 0x21,
+	0x68, 0xbf, 0x61, 0xf4, 0x13, 0xb8, 0x08, 0x06, 0xb5, 0x70, 0x55, 0x7a, 0xfd, 0x76, 0x2f, 0x7a,
+	0xce, 0x0f, 0x5b, 0x86, 0xe0, 0xdf, 0x7a, 0xeb, 0x9a, 0x9b, 0xad, 0x6b, 0xde, 0x6c, 0x5d, 0xf3,
+	0x72, 0xe7, 0x1a, 0x9b, 0x9d, 0x6b, 0x5c, 0xed, 0x5c, 0xe3, 0xe8, 0xfb, 0x81, 0x57, 0xc9, 0xf8,
+	0xb4, 0xbd, 0xbc, 0x4e, 0xf4, 0xe9, 0xf1, 0x05, 0x3e, 0x78, 0x29, 0xda, 0x7d, 0xf4, 0x4a, 0xc3,
+	0xbe, 0xdd, 0x0d, 0x00, 0xfa, 0xbc, 0x43, 0xbd, 0x44, 0x02, 0x00, 0x00,
+}
+
+func (m *BalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Baseline.Size()
+		i -= size
+		if _, err := m.Baseline.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DropThreshold.Size()
+		i -= size
+		if _, err := m.DropThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAlert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAlert(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAlert(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAlert(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAlert(uint64(l))
+	}
+	l = m.DropThreshold.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	l = m.Baseline.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovAlert(uint64(l))
+	return n
+}
+
+func sovAlert(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAlert(x uint64) (n int) {
+	return sovAlert(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DropThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlert
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAlert(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAlert
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAlert
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAlert
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAlert
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAlert        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAlert          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAlert = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/watchtower module sentinel errors
+var (
+	ErrInvalidThreshold = sdkerrors.Register(ModuleName, 2, "drop threshold must be greater than 0 and less than 1")
+	ErrAlertNotFound    = sdkerrors.Register(ModuleName, 3, "alert not found")
+	ErrTooManyAlerts    = sdkerrors.Register(ModuleName, 4, "alert limit reached")
+)
@@ -0,0 +1,14 @@
+package types
+
+// watchtower module event types
+const (
+	EventTypeRegisterBalanceAlert = "register_balance_alert"
+	EventTypeRemoveBalanceAlert   = "remove_balance_alert"
+	EventTypeBalanceDropAlert     = "balance_drop_alert"
+
+	AttributeKeyOwner         = "owner"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyDropThreshold = "drop_threshold"
+	AttributeKeyBaseline      = "baseline"
+	AttributeKeyBalance       = "balance"
+)
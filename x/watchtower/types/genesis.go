@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default watchtower genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Alerts: []BalanceAlert{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	perOwner := map[string]uint32{}
+	for _, alert := range gs.Alerts {
+		if err := alert.Validate(); err != nil {
+			return err
+		}
+		id := alert.Owner + "/" + alert.Denom
+		if seen[id] {
+			return fmt.Errorf("duplicate alert for owner %s and denom %s", alert.Owner, alert.Denom)
+		}
+		seen[id] = true
+		perOwner[alert.Owner]++
+		if perOwner[alert.Owner] > gs.Params.MaxAlertsPerAccount {
+			return fmt.Errorf("owner %s has more than %d alerts", alert.Owner, gs.Params.MaxAlertsPerAccount)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/watchtower/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	owner := sdk.AccAddress([]byte("watched_owner_______")).String()
+	alert := types.NewBalanceAlert(owner, "usei", sdk.MustNewDecFromStr("0.2"), sdk.NewInt(1000), types.DefaultAlertDeposit)
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid alerts",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Alerts: []types.BalanceAlert{alert},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate alert",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Alerts: []types.BalanceAlert{alert, alert},
+			},
+			valid: false,
+		},
+		{
+			desc: "too many alerts for an owner",
+			genState: &types.GenesisState{
+				Params: types.Params{MaxAlertsPerAccount: 1, AlertDeposit: types.DefaultAlertDeposit, MaxChecksPerBlock: 1},
+				Alerts: []types.BalanceAlert{
+					alert,
+					types.NewBalanceAlert(owner, "uatom", sdk.MustNewDecFromStr("0.2"), sdk.NewInt(1000), types.DefaultAlertDeposit),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid deposit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Alerts: []types.BalanceAlert{types.NewBalanceAlert(owner, "usei", sdk.MustNewDecFromStr("0.2"), sdk.NewInt(1000), sdk.Coin{Denom: "usei", Amount: sdk.NewInt(-1)})},
+			},
+			valid: false,
+		},
+		{
+			desc: "threshold of one",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Alerts: []types.BalanceAlert{types.NewBalanceAlert(owner, "usei", sdk.OneDec(), sdk.NewInt(1000), types.DefaultAlertDeposit)},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid owner",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Alerts: []types.BalanceAlert{types.NewBalanceAlert("invalid", "usei", sdk.MustNewDecFromStr("0.2"), sdk.NewInt(1000), types.DefaultAlertDeposit)},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
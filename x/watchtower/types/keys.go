@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "watchtower"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the watchtower module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+const (
+	BalanceAlertKey = "balance-alert-"
+	CheckCursorKey  = "check-cursor"
+)
+
+// BalanceAlertPrefix returns the store prefix under which alerts are keyed by
+// length-prefixed owner address bytes followed by denom
+func BalanceAlertPrefix() []byte {
+	return []byte(BalanceAlertKey)
+}
+
+// OwnerAlertPrefix returns the prefix of all alerts registered by an owner,
+// relative to BalanceAlertPrefix
+func OwnerAlertPrefix(owner sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(owner)
+}
+
+// BalanceAlertKeySuffix returns the key of an owner's alert for a denom,
+// relative to BalanceAlertPrefix
+func BalanceAlertKeySuffix(owner sdk.AccAddress, denom string) []byte {
+	return append(OwnerAlertPrefix(owner), []byte(denom)...)
+}
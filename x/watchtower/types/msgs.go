@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRegisterBalanceAlert = "register_balance_alert"
+	TypeMsgRemoveBalanceAlert   = "remove_balance_alert"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterBalanceAlert{}
+	_ sdk.Msg = &MsgRemoveBalanceAlert{}
+)
+
+// NewMsgRegisterBalanceAlert creates a msg to register a balance alert
+func NewMsgRegisterBalanceAlert(owner, denom string, dropThreshold sdk.Dec) *MsgRegisterBalanceAlert {
+	return &MsgRegisterBalanceAlert{
+		Owner:         owner,
+		Denom:         denom,
+		DropThreshold: dropThreshold,
+	}
+}
+
+func (m MsgRegisterBalanceAlert) Route() string { return RouterKey }
+func (m MsgRegisterBalanceAlert) Type() string  { return TypeMsgRegisterBalanceAlert }
+func (m MsgRegisterBalanceAlert) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateDropThreshold(m.DropThreshold)
+}
+
+func (m MsgRegisterBalanceAlert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterBalanceAlert) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRemoveBalanceAlert creates a msg to remove a balance alert
+func NewMsgRemoveBalanceAlert(owner, denom string) *MsgRemoveBalanceAlert {
+	return &MsgRemoveBalanceAlert{
+		Owner: owner,
+		Denom: denom,
+	}
+}
+
+func (m MsgRemoveBalanceAlert) Route() string { return RouterKey }
+func (m MsgRemoveBalanceAlert) Type() string  { return TypeMsgRemoveBalanceAlert }
+func (m MsgRemoveBalanceAlert) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+func (m MsgRemoveBalanceAlert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveBalanceAlert) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	KeyMaxAlertsPerAccount = []byte("MaxAlertsPerAccount")
+	KeyAlertDeposit        = []byte("AlertDeposit")
+	KeyMaxChecksPerBlock   = []byte("MaxChecksPerBlock")
+)
+
+const (
+	DefaultMaxAlertsPerAccount = 5
+	DefaultMaxChecksPerBlock   = 1000
+)
+
+var DefaultAlertDeposit = sdk.NewInt64Coin("usei", 1000000) // 1SEI
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the watchtower module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxAlertsPerAccount: DefaultMaxAlertsPerAccount,
+		AlertDeposit:        DefaultAlertDeposit,
+		MaxChecksPerBlock:   DefaultMaxChecksPerBlock,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxAlertsPerAccount, &p.MaxAlertsPerAccount, validateMaxAlertsPerAccount),
+		paramtypes.NewParamSetPair(KeyAlertDeposit, &p.AlertDeposit, validateAlertDeposit),
+		paramtypes.NewParamSetPair(KeyMaxChecksPerBlock, &p.MaxChecksPerBlock, validateMaxChecksPerBlock),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxAlertsPerAccount(p.MaxAlertsPerAccount); err != nil {
+		return err
+	}
+	if err := validateAlertDeposit(p.AlertDeposit); err != nil {
+		return err
+	}
+	return validateMaxChecksPerBlock(p.MaxChecksPerBlock)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateMaxAlertsPerAccount(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAlertDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return deposit.Validate()
+}
+
+func validateMaxChecksPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max checks per block must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: watchtower/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the watchtower module.
+type Params struct {
+	// max_alerts_per_account caps how many alerts a single owner can register.
+	MaxAlertsPerAccount uint32 `protobuf:"varint,1,opt,name=max_alerts_per_account,json=maxAlertsPerAccount,proto3" json:"max_alerts_per_account" yaml:"max_alerts_per_account"`
+	// alert_deposit is escrowed from the owner for every new alert and refunded
+	// when the alert is removed.
+	AlertDeposit types.Coin `protobuf:"bytes,2,opt,name=alert_deposit,json=alertDeposit,proto3" json:"alert_deposit" yaml:"alert_deposit"`
+	// max_checks_per_block caps how many alerts are checked in one EndBlock.
+	// When there are more alerts, the checks resume where the last block
+	// stopped.
+	MaxChecksPerBlock uint64 `protobuf:"varint,3,opt,name=max_checks_per_block,json=maxChecksPerBlock,proto3" json:"max_checks_per_block" yaml:"max_checks_per_block"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4db0e499d1f866c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxAlertsPerAccount() uint32 {
+	if m != nil {
+		return m.MaxAlertsPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetAlertDeposit() types.Coin {
+	if m != nil {
+		return m.AlertDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxChecksPerBlock() uint64 {
+	if m != nil {
+		return m.MaxChecksPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.watchtower.Params")
+}
+
+func init() { proto.RegisterFile("watchtower/params.proto", fileDescriptor_e4db0e499d1f866c) }
+
+var fileDescriptor_e4db0e499d1f866c = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x8f, 0xda, 0x30,
+	0x14, 0xc7, 0x63, 0x5a, 0xa1, 0x2a, 0x2d, 0x43, 0x53, 0xd4, 0x52, 0xaa, 0xc6, 0x28, 0x13, 0x0b,
+	0xb6, 0x68, 0x2b, 0x55, 0xa2, 0x13, 0xa1, 0x73, 0x85, 0x18, 0xbb, 0x44, 0x8e, 0xcf, 0x22, 0x16,
+	0x71, 0x1c, 0xc5, 0xe6, 0x08, 0xdf, 0xe2, 0xc6, 0x1b, 0xf9, 0x38, 0x8c, 0x8c, 0xb7, 0x5c, 0x74,
+	0x82, 0xe5, 0xc4, 0xc8, 0x27, 0x38, 0xc5, 0xb9, 0x13, 0xc7, 0x89, 0xdb, 0xde, 0xfb, 0xff, 0xff,
+	0x4f, 0xef, 0x67, 0x3d, 0xdb, 0x5f, 0x16, 0x44, 0xd3, 0x48, 0xcb, 0x05, 0xcb, 0x70, 0x4a, 0x32,
+	0x22, 0x14, 0x4a, 0x33, 0xa9, 0xa5, 0x03, 0x15, 0xe3, 0xa6, 0xa2, 0x32, 0x46, 0x8a, 0x71, 0x1a,
+	0x11, 0x9e, 0xa0, 0x63, 0xba, 0xdd, 0x9c, 0xca, 0xa9, 0x34, 0x09, 0x5c, 0x56, 0xd5, 0x58, 0xdb,
+	0xa5, 0x52, 0x09, 0xa9, 0x70, 0x48, 0x14, 0xc3, 0x97, 0xfd, 0x90, 0x69, 0xd2, 0xc7, 0x54, 0xf2,
+	0xa4, 0xf2, 0xbd, 0xdb, 0x9a, 0x5d, 0x1f, 0x9b, 0x3d, 0x4e, 0x6a, 0x7f, 0x16, 0x24, 0x0f, 0x48,
+	0xcc, 0x32, 0xad, 0x82, 0x94, 0x65, 0x01, 0xa1, 0x54, 0xce, 0x13, 0xdd, 0x02, 0x1d, 0xd0, 0x6d,
+	0xf8, 0x7f, 0xf6, 0x05, 0x7c, 0x25, 0x71, 0x28, 0xe0, 0xf7, 0x25, 0x11, 0xf1, 0xc0, 0x3b, 0xef,
+	0x7b, 0x93, 0x4f, 0x82, 0xe4, 0x43, 0xa3, 0x8f, 0x59, 0x36, 0xac, 0x54, 0x47, 0xd8, 0x0d, 0x93,
+	0x0d, 0x2e, 0x58, 0x2a, 0x15, 0xd7, 0xad, 0x5a, 0x07, 0x74, 0xdf, 0xff, 0xf8, 0x8a, 0x2a, 0x68,
+	0x54, 0x42, 0xa3, 0x47, 0x68, 0x34, 0x92, 0x3c, 0xf1, 0x7b, 0xeb, 0x02, 0x5a, 0xfb, 0x02, 0x9e,
+	0xce, 0x1d, 0x0a, 0xd8, 0xac, 0xd6, 0x9f, 0xc8, 0xde, 0xe4, 0x83, 0xe9, 0xff, 0x56, 0xad, 0x13,
+	0xd9, 0xcd, 0x12, 0x8f, 0x46, 0x8c, 0xce, 0x2a, 0xbc, 0x30, 0x96, 0x74, 0xd6, 0x7a, 0xd3, 0x01,
+	0xdd, 0xb7, 0xfe, 0xef, 0x7d, 0x01, 0xcf, 0xfa, 0x87, 0x02, 0x7e, 0x3b, 0x3e, 0xee, 0xa5, 0xeb,
+	0x4d, 0x3e, 0x0a, 0x92, 0x8f, 0x8c, 0x3a, 0x66, 0x99, 0x5f, 0x6a, 0x83, 0x77, 0xd7, 0x2b, 0x68,
+	0xdd, 0xaf, 0x20, 0xf0, 0xff, 0xad, 0xb7, 0x2e, 0xd8, 0x6c, 0x5d, 0x70, 0xb7, 0x75, 0xc1, 0xd5,
+	0xce, 0xb5, 0x36, 0x3b, 0xd7, 0xba, 0xd9, 0xb9, 0xd6, 0xff, 0x5f, 0x53, 0xae, 0xa3, 0x79, 0x88,
+	0xa8, 0x14, 0x58, 0x31, 0xde, 0x7b, 0x3a, 0xae, 0x69, 0xcc, 0x75, 0x71, 0x8e, 0x9f, 0xfd, 0x06,
+	0xbd, 0x4c, 0x99, 0x0a, 0xeb, 0x26, 0xf6, 0xf3, 0x61, 0x00, 0x53, 0xda, 0x47, 0x2c, 0x28, 0x02,
+	0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAlertsPerAccount != that1.MaxAlertsPerAccount {
+		return false
+	}
+	if !this.AlertDeposit.Equal(&that1.AlertDeposit) {
+		return false
+	}
+	if this.MaxChecksPerBlock != that1.MaxChecksPerBlock {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChecksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChecksPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.AlertDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxAlertsPerAccount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAlertsPerAccount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAlertsPerAccount != 0 {
+		n += 1 + sovParams(uint64(m.MaxAlertsPerAccount))
+	}
+	l = m.AlertDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxChecksPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxChecksPerBlock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAlertsPerAccount", wireType)
+			}
+			m.MaxAlertsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAlertsPerAccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlertDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChecksPerBlock", wireType)
+			}
+			m.MaxChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChecksPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: watchtower/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterBalanceAlert registers, or replaces, the owner's alert for a
+// denom. The current balance becomes the alert's baseline.
+type MsgRegisterBalanceAlert struct {
+	Owner         string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Denom         string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	DropThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=drop_threshold,json=dropThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drop_threshold" yaml:"drop_threshold"`
+}
+
+func (m *MsgRegisterBalanceAlert) Reset()         { *m = MsgRegisterBalanceAlert{} }
+func (m *MsgRegisterBalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBalanceAlert) ProtoMessage()    {}
+func (*MsgRegisterBalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f602de590c6a6ce2, []int{0}
+}
+func (m *MsgRegisterBalanceAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBalanceAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBalanceAlert.Merge(m, src)
+}
+func (m *MsgRegisterBalanceAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBalanceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBalanceAlert proto.InternalMessageInfo
+
+func (m *MsgRegisterBalanceAlert) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterBalanceAlert) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRegisterBalanceAlertResponse struct {
+}
+
+func (m *MsgRegisterBalanceAlertResponse) Reset()         { *m = MsgRegisterBalanceAlertResponse{} }
+func (m *MsgRegisterBalanceAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBalanceAlertResponse) ProtoMessage()    {}
+func (*MsgRegisterBalanceAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f602de590c6a6ce2, []int{1}
+}
+func (m *MsgRegisterBalanceAlertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBalanceAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBalanceAlertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBalanceAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBalanceAlertResponse.Merge(m, src)
+}
+func (m *MsgRegisterBalanceAlertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBalanceAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBalanceAlertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBalanceAlertResponse proto.InternalMessageInfo
+
+// MsgRemoveBalanceAlert removes the owner's alert for a denom.
+type MsgRemoveBalanceAlert struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgRemoveBalanceAlert) Reset()         { *m = MsgRemoveBalanceAlert{} }
+func (m *MsgRemoveBalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBalanceAlert) ProtoMessage()    {}
+func (*MsgRemoveBalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f602de590c6a6ce2, []int{2}
+}
+func (m *MsgRemoveBalanceAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBalanceAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBalanceAlert.Merge(m, src)
+}
+func (m *MsgRemoveBalanceAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBalanceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBalanceAlert proto.InternalMessageInfo
+
+func (m *MsgRemoveBalanceAlert) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRemoveBalanceAlert) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveBalanceAlertResponse struct {
+}
+
+func (m *MsgRemoveBalanceAlertResponse) Reset()         { *m = MsgRemoveBalanceAlertResponse{} }
+func (m *MsgRemoveBalanceAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBalanceAlertResponse) ProtoMessage()    {}
+func (*MsgRemoveBalanceAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f602de590c6a6ce2, []int{3}
+}
+func (m *MsgRemoveBalanceAlertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBalanceAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBalanceAlertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBalanceAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBalanceAlertResponse.Merge(m, src)
+}
+func (m *MsgRemoveBalanceAlertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBalanceAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBalanceAlertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBalanceAlertResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterBalanceAlert)(nil), "seiprotocol.seichain.watchtower.MsgRegisterBalanceAlert")
+	proto.RegisterType((*MsgRegisterBalanceAlertResponse)(nil), "seiprotocol.seichain.watchtower.MsgRegisterBalanceAlertResponse")
+	proto.RegisterType((*MsgRemoveBalanceAlert)(nil), "seiprotocol.seichain.watchtower.MsgRemoveBalanceAlert")
+	proto.RegisterType((*MsgRemoveBalanceAlertResponse)(nil), "seiprotocol.seichain.watchtower.MsgRemoveBalanceAlertResponse")
+}
+
+func init() { proto.RegisterFile("watchtower/tx.proto", fileDescriptor_f602de590c6a6ce2) }
+
+var fileDescriptor_f602de590c6a6ce2 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x4a, 0xeb, 0x50,
+	0x10, 0xce, 0x69, 0xb9, 0x17, 0xee, 0xe1, 0x2a, 0x12, 0x5b, 0x2c, 0x05, 0x13, 0xcd, 0xa2, 0xb8,
+	0x69, 0x02, 0x2a, 0x22, 0x2e, 0x44, 0x8b, 0xe0, 0xaa, 0x2e, 0x82, 0x2b, 0x37, 0x92, 0x26, 0x43,
+	0x12, 0x4c, 0x32, 0x21, 0xe7, 0x68, 0xdb, 0x77, 0x70, 0x21, 0xbe, 0x85, 0x6f, 0xd2, 0x65, 0x97,
+	0xea, 0x22, 0x48, 0xfb, 0x06, 0x7d, 0x02, 0xc9, 0x69, 0xa3, 0xb5, 0xb4, 0x28, 0x05, 0x57, 0x99,
+	0x9f, 0xef, 0x9b, 0x99, 0x2f, 0x33, 0x87, 0xae, 0xb7, 0x2d, 0x6e, 0x7b, 0x1c, 0xdb, 0x90, 0x18,
+	0xbc, 0xa3, 0xc7, 0x09, 0x72, 0x94, 0x55, 0x06, 0xbe, 0xb0, 0x6c, 0x0c, 0x74, 0x06, 0xbe, 0xed,
+	0x59, 0x7e, 0xa4, 0x7f, 0x22, 0xab, 0x25, 0x17, 0x5d, 0x14, 0x08, 0x23, 0xb3, 0xc6, 0x34, 0xed,
+	0x85, 0xd0, 0x8d, 0x26, 0x73, 0x4d, 0x70, 0x7d, 0xc6, 0x21, 0x69, 0x58, 0x81, 0x15, 0xd9, 0x70,
+	0x1a, 0x40, 0xc2, 0xe5, 0x1a, 0xfd, 0x83, 0xed, 0x08, 0x92, 0x0a, 0xd9, 0x22, 0x3b, 0xff, 0x1a,
+	0x6b, 0xa3, 0x54, 0xfd, 0xdf, 0xb5, 0xc2, 0xe0, 0x48, 0x13, 0x61, 0xcd, 0x1c, 0xa7, 0x33, 0x9c,
+	0x03, 0x11, 0x86, 0x95, 0xc2, 0x2c, 0x4e, 0x84, 0x35, 0x73, 0x9c, 0x96, 0x23, 0xba, 0xea, 0x24,
+	0x18, 0x5f, 0x73, 0x2f, 0x01, 0xe6, 0x61, 0xe0, 0x54, 0x8a, 0x82, 0x70, 0xde, 0x4b, 0x55, 0xe9,
+	0x35, 0x55, 0x6b, 0xae, 0xcf, 0xbd, 0xdb, 0x96, 0x6e, 0x63, 0x68, 0xd8, 0xc8, 0x42, 0x64, 0x93,
+	0x4f, 0x9d, 0x39, 0x37, 0x06, 0xef, 0xc6, 0xc0, 0xf4, 0x33, 0xb0, 0x47, 0xa9, 0x5a, 0x9e, 0x94,
+	0xff, 0x52, 0x4d, 0x33, 0x57, 0xb2, 0xc0, 0xe5, 0x87, 0xbf, 0x4d, 0xd5, 0x05, 0xd2, 0x4c, 0x60,
+	0x31, 0x46, 0x0c, 0x34, 0x97, 0x96, 0x05, 0x24, 0xc4, 0x3b, 0xf8, 0x4d, 0xed, 0x9a, 0x4a, 0x37,
+	0xe7, 0x36, 0xca, 0x27, 0xd9, 0x7d, 0x2a, 0xd0, 0x62, 0x93, 0xb9, 0xf2, 0x23, 0xa1, 0xa5, 0xb9,
+	0xdb, 0x38, 0xd4, 0xbf, 0xd9, 0xb0, 0xbe, 0x40, 0x6c, 0xf5, 0x64, 0x59, 0x66, 0x3e, 0x9c, 0x7c,
+	0x4f, 0xa8, 0x3c, 0xe7, 0x27, 0x1d, 0xfc, 0xac, 0xf0, 0x2c, 0xaf, 0x7a, 0xbc, 0x1c, 0x2f, 0x1f,
+	0xa7, 0x71, 0xd1, 0x1b, 0x28, 0xa4, 0x3f, 0x50, 0xc8, 0xdb, 0x40, 0x21, 0x0f, 0x43, 0x45, 0xea,
+	0x0f, 0x15, 0xe9, 0x79, 0xa8, 0x48, 0x57, 0xfb, 0x53, 0x27, 0xc4, 0xc0, 0xaf, 0xe7, 0x4d, 0x84,
+	0x23, 0xba, 0x18, 0x1d, 0x63, 0xfa, 0xf9, 0x64, 0x47, 0xd5, 0xfa, 0x2b, 0x60, 0x7b, 0xef, 0x03,
+	0x00, 0xc5, 0x99, 0x0d, 0x93, 0x59, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterBalanceAlert(ctx context.Context, in *MsgRegisterBalanceAlert, opts ...grpc.CallOption) (*MsgRegisterBalanceAlertResponse, error)
+	RemoveBalanceAlert(ctx context.Context, in *MsgRemoveBalanceAlert, opts ...grpc.CallOption) (*MsgRemoveBalanceAlertResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterBalanceAlert(ctx context.Context, in *MsgRegisterBalanceAlert, opts ...grpc.CallOption) (*MsgRegisterBalanceAlertResponse, error) {
+	out := new(MsgRegisterBalanceAlertResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.watchtower.Msg/RegisterBalanceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBalanceAlert(ctx context.Context, in *MsgRemoveBalanceAlert, opts ...grpc.CallOption) (*MsgRemoveBalanceAlertResponse, error) {
+	out := new(MsgRemoveBalanceAlertResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.watchtower.Msg/RemoveBalanceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterBalanceAlert(context.Context, *MsgRegisterBalanceAlert) (*MsgRegisterBalanceAlertResponse, error)
+	RemoveBalanceAlert(context.Context, *MsgRemoveBalanceAlert) (*MsgRemoveBalanceAlertResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterBalanceAlert(ctx context.Context, req *MsgRegisterBalanceAlert) (*MsgRegisterBalanceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBalanceAlert not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBalanceAlert(ctx context.Context, req *MsgRemoveBalanceAlert) (*MsgRemoveBalanceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBalanceAlert not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterBalanceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBalanceAlert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBalanceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.watchtower.Msg/RegisterBalanceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBalanceAlert(ctx, req.(*MsgRegisterBalanceAlert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBalanceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBalanceAlert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBalanceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.watchtower.Msg/RemoveBalanceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBalanceAlert(ctx, req.(*MsgRemoveBalanceAlert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.watchtower.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterBalanceAlert",
+			Handler:    _Msg_RegisterBalanceAlert_Handler,
+		},
+		{
+			MethodName: "RemoveBalanceAlert",
+			Handler:    _Msg_RemoveBalanceAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "watchtower/tx.proto",
+}
+
+func (m *MsgRegisterBalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DropThreshold.Size()
+		i -= size
+		if _, err := m.DropThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBalanceAlertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBalanceAlertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBalanceAlertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBalanceAlertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBalanceAlertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBalanceAlertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterBalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DropThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterBalanceAlertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBalanceAlertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterBalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DropThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBalanceAlertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBalanceAlertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBalanceAlertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBalanceAlertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBalanceAlertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBalanceAlertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)